@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"gamerpal/internal/bot"
 	"gamerpal/internal/config"
@@ -25,7 +28,11 @@ func main() {
 		cfg.Logger.Fatal("Failed to create bot:", err)
 	}
 
-	if err := bestPalBot.Start(); err != nil {
+	// Cancelled on SIGINT/SIGTERM so the bot can shut down gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := bestPalBot.Start(ctx); err != nil {
 		cfg.Logger.Fatal("Failed to start bot:", err)
 	}
 }
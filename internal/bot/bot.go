@@ -5,9 +5,7 @@ import (
 	"fmt"
 	"math/rand/v2"
 	"os"
-	"os/signal"
 	"sync/atomic"
-	"syscall"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -17,12 +15,23 @@ import (
 	"gamerpal/internal/commands/modules/agentadapter"
 	"gamerpal/internal/commands/modules/intro"
 	nineteeneightyfour "gamerpal/internal/commands/modules/nineteeneightyfour"
+	"gamerpal/internal/commands/modules/ping"
 	"gamerpal/internal/commands/modules/scamguard"
 	"gamerpal/internal/config"
 	"gamerpal/internal/events"
+	"gamerpal/internal/health"
 	"gamerpal/internal/scheduler"
+	"gamerpal/internal/telemetry"
+	"gamerpal/internal/utils"
 )
 
+// shutdownTimeout bounds how long module services get to flush state on exit.
+const shutdownTimeout = 15 * time.Second
+
+// forumSyncMaxAge is how stale a forum's last full sync may be before /readyz
+// fails; a little over two 6h refresh cycles so one failed run is tolerated.
+const forumSyncMaxAge = 13 * time.Hour
+
 // Bot represents the Discord bot
 type Bot struct {
 	session              *discordgo.Session
@@ -30,7 +39,10 @@ type Bot struct {
 	commandModuleHandler *commands.ModuleHandler
 	scheduler            *scheduler.Scheduler
 	agent                *agentengine.Agent
-	ready                atomic.Bool // guards interaction handling until startup completes
+	health               *health.Server // nil unless health_port is set
+	startedAt            time.Time
+	ctx                  context.Context // cancelled when shutdown begins
+	ready                atomic.Bool     // guards interaction handling until startup completes
 }
 
 // New creates a new Bot instance
@@ -73,8 +85,8 @@ func New(cfg *config.Config) (*Bot, error) {
 	// mark not ready yet (zero value false, explicit for clarity)
 	bot.ready.Store(false)
 
-	// Set intents - we need guild, member, message, message content, direct message, message reaction, and guild scheduled event intents
-	session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMembers | discordgo.IntentsGuildMessages | discordgo.IntentMessageContent | discordgo.IntentDirectMessages | discordgo.IntentsGuildMessageReactions | discordgo.IntentsGuildScheduledEvents
+	// Set intents - we need guild, member, message, message content, direct message, message reaction, guild scheduled event, and voice state (LFG temp voice cleanup) intents
+	session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMembers | discordgo.IntentsGuildMessages | discordgo.IntentMessageContent | discordgo.IntentDirectMessages | discordgo.IntentsGuildMessageReactions | discordgo.IntentsGuildScheduledEvents | discordgo.IntentsGuildVoiceStates
 
 	// Enable per-channel message caching so the 1984 module can show the
 	// "before" version of edited and deleted messages. discordgo populates
@@ -134,6 +146,7 @@ func New(cfg *config.Config) (*Bot, error) {
 			if introMod, ok := handler.GetModule("intro").(*intro.Module); ok {
 				if feedService := introMod.GetFeedService(); feedService != nil {
 					feedService.HandleNewIntroThread(e.Channel)
+					feedService.NotifyGreeters(e.Channel)
 				}
 			}
 		}
@@ -147,6 +160,9 @@ func New(cfg *config.Config) (*Bot, error) {
 	session.AddHandler(func(s *discordgo.Session, e *discordgo.ThreadListSync) {
 		handler.GetForumCache().OnThreadListSync(s, e)
 	})
+	session.AddHandler(func(s *discordgo.Session, e *discordgo.MessageCreate) {
+		handler.GetForumCache().OnMessageCreate(s, e)
+	})
 
 	// Reaction events (used by Connect 4 and other reaction-based features)
 	session.AddHandler(func(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
@@ -159,8 +175,29 @@ func New(cfg *config.Config) (*Bot, error) {
 	return bot, nil
 }
 
-// Start starts the bot
-func (b *Bot) Start() error {
+// Start starts the bot and blocks until ctx is cancelled, then shuts down
+// gracefully.
+func (b *Bot) Start(ctx context.Context) error {
+	b.ctx = ctx
+	b.startedAt = time.Now()
+	if pingMod, ok := b.commandModuleHandler.GetModule("ping").(*ping.Module); ok {
+		pingMod.SetStartTime(b.startedAt)
+	}
+
+	// Start the health server first so liveness probes pass during startup.
+	if b.config.GetMetricsEnabled() && b.config.GetHealthPort() == 0 {
+		b.config.Logger.Warn("metrics_enabled is set but health_port is not; metrics will not be served")
+	}
+	if port := b.config.GetHealthPort(); port > 0 {
+		b.health = health.NewServer(fmt.Sprintf(":%d", port), b.config.Logger, b.readinessChecks()...)
+		if b.config.GetMetricsEnabled() {
+			b.health.Handle("/metrics", b.newTelemetry())
+		}
+		if err := b.health.Start(); err != nil {
+			return fmt.Errorf("error starting health server: %w", err)
+		}
+	}
+
 	// Start the LLM agent's Copilot CLI subprocess (best-effort). If this
 	// fails, the agent is left disabled and the bot continues without it.
 	if b.agent != nil {
@@ -197,7 +234,7 @@ func (b *Bot) Start() error {
 	if introForum := b.config.GetGamerPalsIntroductionsForumChannelID(); introForum != "" {
 		b.commandModuleHandler.GetForumCache().RegisterForum(introForum)
 	}
-	if lfgForum := b.config.GetGamerPalsLFGForumChannelID(); lfgForum != "" {
+	for _, lfgForum := range b.config.GetLFGForumChannelIDs() {
 		b.commandModuleHandler.GetForumCache().RegisterForum(lfgForum)
 	}
 
@@ -220,8 +257,33 @@ func (b *Bot) Start() error {
 		b.config.Logger.Errorf("Failed to register log rotation: %v", err)
 	}
 
+	// Periodically reconcile the forum cache in case gateway events were missed;
+	// any drift found is logged and counted as anomalies.
+	if err := b.scheduler.RegisterContextFunc("@every 6h", "forum-cache-refresh", func(ctx context.Context) error {
+		guildID := b.config.GetGamerPalsServerID()
+		if guildID == "" {
+			return nil
+		}
+		_, err := b.commandModuleHandler.GetForumCache().ReconcileAll(ctx, guildID)
+		return err
+	}); err != nil {
+		b.config.Logger.Errorf("Failed to register forum cache refresh: %v", err)
+	}
+
+	// Post an hourly warn/error digest to the log channel (skipped when quiet)
+	if tally := b.config.LogTally(); tally != nil {
+		if err := b.scheduler.RegisterFunc("@hourly", "log-digest", func() error {
+			digest := tally.Snapshot(5)
+			if digest.Empty() {
+				return nil
+			}
+			return utils.LogToChannel(b.config, b.session, digest.String())
+		}); err != nil {
+			b.config.Logger.Errorf("Failed to register log digest: %v", err)
+		}
+	}
+
 	b.scheduler.Start()
-	defer b.scheduler.Stop()
 
 	// Update status to indicate the bot is awake
 	if err := b.session.UpdateGameStatus(0, "OK OK I'm awake!"); err != nil {
@@ -233,10 +295,9 @@ func (b *Bot) Start() error {
 	b.config.Logger.Info("Initialization complete; interactions enabled")
 	b.config.Logger.Info("GamerPal bot is now running. Press CTRL+C to exit.")
 
-	// Wait for interrupt signal
-	sc := make(chan os.Signal, 1)
-	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
-	<-sc
+	// Wait for shutdown (cancelled by the caller on SIGINT/SIGTERM)
+	<-ctx.Done()
+	b.shutdown()
 
 	// Cleanup: Unregister commands, optionally
 	if os.Getenv("UNREGISTER_COMMANDS") == "true" {
@@ -246,6 +307,70 @@ func (b *Bot) Start() error {
 	return nil
 }
 
+// shutdown stops accepting interactions, waits for running scheduled jobs, and
+// lets module services flush transient state before the session is closed.
+func (b *Bot) shutdown() {
+	b.config.Logger.Info("Shutting down...")
+	b.ready.Store(false)
+	b.scheduler.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	b.commandModuleHandler.ShutdownModuleServices(ctx)
+	if b.health != nil {
+		if err := b.health.Shutdown(ctx); err != nil {
+			b.config.Logger.Warnf("error stopping health server: %v", err)
+		}
+	}
+	b.config.Logger.Info("Shutdown complete")
+}
+
+// newTelemetry builds the metrics registry and wires it into command
+// dispatch. Only called when metrics are enabled.
+func (b *Bot) newTelemetry() *telemetry.Registry {
+	reg := telemetry.NewRegistry()
+	b.commandModuleHandler.EnableTelemetry(reg)
+	reg.GaugeFunc("gamerpal_discord_heartbeat_latency_seconds", "Latest Discord gateway heartbeat round trip.", nil, func() []telemetry.Sample {
+		return []telemetry.Sample{{Value: b.session.HeartbeatLatency().Seconds()}}
+	})
+	return reg
+}
+
+// readinessChecks are the conditions /readyz requires: startup finished, the
+// gateway is connected, and every cached forum has synced recently.
+func (b *Bot) readinessChecks() []health.NamedCheck {
+	return []health.NamedCheck{
+		{Name: "startup", Check: func() error {
+			if !b.ready.Load() {
+				return fmt.Errorf("initialization not complete")
+			}
+			return nil
+		}},
+		{Name: "gateway", Check: func() error {
+			b.session.RLock()
+			connected := b.session.DataReady
+			b.session.RUnlock()
+			if !connected {
+				return fmt.Errorf("not connected")
+			}
+			return nil
+		}},
+		{Name: "forum_sync", Check: func() error {
+			fc := b.commandModuleHandler.GetForumCache()
+			for _, forumID := range fc.RegisteredForums() {
+				stats, ok := fc.Stats(forumID)
+				if !ok || stats.LastFullSync.IsZero() {
+					return fmt.Errorf("forum %s never synced", forumID)
+				}
+				if age := time.Since(stats.LastFullSync); age > forumSyncMaxAge {
+					return fmt.Errorf("forum %s last synced %s ago", forumID, age.Round(time.Minute))
+				}
+			}
+			return nil
+		}},
+	}
+}
+
 // onReady handles the ready event
 func (b *Bot) onReady(s *discordgo.Session, r *discordgo.Ready) {
 	b.config.Logger.Infof("Bot received ready signal! Logged in as: %s#%s\n", r.User.Username, r.User.Discriminator)
@@ -263,11 +388,11 @@ func (b *Bot) onReady(s *discordgo.Session, r *discordgo.Ready) {
 				b.config.Logger.Infof("Intro forum preload complete")
 			}
 		}
-		if lfgForum := b.config.GetGamerPalsLFGForumChannelID(); lfgForum != "" {
+		for _, lfgForum := range b.config.GetLFGForumChannelIDs() {
 			if err := b.commandModuleHandler.GetForumCache().RefreshForum(guildID, lfgForum); err != nil {
-				b.config.Logger.Warnf("LFG forum preload failed: %v", err)
+				b.config.Logger.Warnf("LFG forum %s preload failed: %v", lfgForum, err)
 			} else {
-				b.config.Logger.Infof("LFG forum preload complete")
+				b.config.Logger.Infof("LFG forum %s preload complete", lfgForum)
 			}
 		}
 	}()
@@ -275,10 +400,16 @@ func (b *Bot) onReady(s *discordgo.Session, r *discordgo.Ready) {
 	// Set bot status to something fresh every hour
 	c := time.NewTicker(time.Hour)
 	go func() {
-		for range c.C {
-			err := s.UpdateGameStatus(0, b.randomStatus())
-			if err != nil {
-				b.config.Logger.Warn("Error setting status:", err)
+		defer c.Stop()
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-c.C:
+				err := s.UpdateGameStatus(0, b.randomStatus())
+				if err != nil {
+					b.config.Logger.Warn("Error setting status:", err)
+				}
 			}
 		}
 	}()
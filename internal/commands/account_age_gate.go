@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	internalConfig "gamerpal/internal/config"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// staffPermissions exempt a member from the account age gate.
+const staffPermissions = discordgo.PermissionAdministrator | discordgo.PermissionManageGuild | discordgo.PermissionBanMembers
+
+// accountAgeGateReason returns why the invoking member may not run cmd yet, or
+// "" when the command is allowed. Only public guild commands are gated:
+// commands that require a permission, staff members, and exempt commands
+// always pass.
+func accountAgeGateReason(gc *internalConfig.GuildConfig, name string, cmd *types.Command, i *discordgo.InteractionCreate, now time.Time) string {
+	if !gc.GetAccountAgeGateEnabled() || i.GuildID == "" || i.Member == nil || i.Member.User == nil {
+		return ""
+	}
+	if cmd.ApplicationCommand != nil && cmd.ApplicationCommand.DefaultMemberPermissions != nil {
+		return ""
+	}
+	if i.Member.Permissions&staffPermissions != 0 {
+		return ""
+	}
+	if slices.Contains(gc.GetAccountAgeGateExemptCommands(), name) {
+		return ""
+	}
+
+	if minAge := gc.GetAccountAgeGateMinAccountAge(); minAge > 0 {
+		if created, err := discordgo.SnowflakeTimestamp(i.Member.User.ID); err == nil {
+			if wait := created.Add(minAge).Sub(now); wait > 0 {
+				return fmt.Sprintf("⏳ Your Discord account is too new to use /%s here. Please try again in %s.", name, roundWait(wait))
+			}
+		}
+	}
+	if minAge := gc.GetAccountAgeGateMinMemberAge(); minAge > 0 && !i.Member.JoinedAt.IsZero() {
+		if wait := i.Member.JoinedAt.Add(minAge).Sub(now); wait > 0 {
+			return fmt.Sprintf("⏳ You joined the server recently, so /%s isn't available to you yet. Please try again in %s.", name, roundWait(wait))
+		}
+	}
+	return ""
+}
+
+// roundWait renders a remaining wait coarsely, e.g. "3 days" or "5 hours".
+func roundWait(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%d days", int(d.Hours()/24))
+	case d >= 2*time.Hour:
+		return fmt.Sprintf("%d hours", int(d.Hours()))
+	case d >= 2*time.Minute:
+		return fmt.Sprintf("%d minutes", int(d.Minutes()))
+	default:
+		return "a minute"
+	}
+}
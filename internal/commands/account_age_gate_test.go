@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	internalConfig "gamerpal/internal/config"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+// snowflakeAt builds a user ID whose embedded creation time is t.
+func snowflakeAt(t time.Time) string {
+	ms := t.UnixMilli() - 1420070400000 // Discord epoch
+	return strconv.FormatInt(ms<<22, 10)
+}
+
+func TestAccountAgeGateReason(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	gc := internalConfig.NewMockConfig(map[string]any{
+		internalConfig.KeyAccountAgeGateEnabled:       true,
+		internalConfig.KeyAccountAgeGateMinAccountAge: "168h",
+		internalConfig.KeyAccountAgeGateMinMemberAge:  "24h",
+		internalConfig.KeyAccountAgeGateExemptCmds:    "help, /ping",
+	}).ForGuild("g1")
+
+	public := &types.Command{ApplicationCommand: &discordgo.ApplicationCommand{Name: "lfg"}}
+	var banPerms int64 = discordgo.PermissionBanMembers
+	modOnly := &types.Command{ApplicationCommand: &discordgo.ApplicationCommand{Name: "say", DefaultMemberPermissions: &banPerms}}
+
+	member := func(accountAge, memberAge time.Duration, perms int64) *discordgo.InteractionCreate {
+		return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			GuildID: "g1",
+			Member: &discordgo.Member{
+				User:        &discordgo.User{ID: snowflakeAt(now.Add(-accountAge))},
+				JoinedAt:    now.Add(-memberAge),
+				Permissions: perms,
+			},
+		}}
+	}
+
+	t.Run("new account is rejected with a reason", func(t *testing.T) {
+		reason := accountAgeGateReason(gc, "lfg", public, member(3*24*time.Hour, 30*24*time.Hour, 0), now)
+		assert.Contains(t, reason, "account is too new")
+		assert.Contains(t, reason, "4 days")
+	})
+
+	t.Run("recent join is rejected", func(t *testing.T) {
+		reason := accountAgeGateReason(gc, "lfg", public, member(365*24*time.Hour, time.Hour, 0), now)
+		assert.Contains(t, reason, "joined the server recently")
+		assert.Contains(t, reason, "23 hours")
+	})
+
+	t.Run("established member passes", func(t *testing.T) {
+		assert.Empty(t, accountAgeGateReason(gc, "lfg", public, member(365*24*time.Hour, 30*24*time.Hour, 0), now))
+	})
+
+	t.Run("exemptions", func(t *testing.T) {
+		fresh := member(time.Hour, time.Hour, 0)
+		assert.Empty(t, accountAgeGateReason(gc, "help", public, fresh, now), "exempt command")
+		assert.Empty(t, accountAgeGateReason(gc, "ping", public, fresh, now), "exempt command with slash")
+		assert.Empty(t, accountAgeGateReason(gc, "say", modOnly, fresh, now), "permissioned command")
+		assert.Empty(t, accountAgeGateReason(gc, "lfg", public, member(time.Hour, time.Hour, discordgo.PermissionBanMembers), now), "staff member")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		off := internalConfig.NewMockConfig(map[string]any{}).ForGuild("g1")
+		assert.Empty(t, accountAgeGateReason(off, "lfg", public, member(time.Hour, time.Hour, 0), now))
+	})
+}
@@ -94,5 +94,44 @@ func (coreConfigProvider) ConfigSettings() []config.Setting {
 			Description: "Channel where newly scheduled server events are announced.",
 			Kind:        config.KindChannel,
 		},
+		{
+			Key:         config.KeyAccountAgeGateEnabled,
+			Category:    config.CategoryMisc,
+			Label:       "Account age gate",
+			Description: "Block public commands for brand-new accounts or members. Staff are exempt.",
+			Kind:        config.KindBool,
+			Default:     false,
+		},
+		{
+			Key:         config.KeyAccountAgeGateMinAccountAge,
+			Category:    config.CategoryMisc,
+			Label:       "Minimum account age",
+			Description: "How old a Discord account must be to use public commands (e.g. 168h). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "168h",
+		},
+		{
+			Key:         config.KeyAccountAgeGateMinMemberAge,
+			Category:    config.CategoryMisc,
+			Label:       "Minimum member age",
+			Description: "How long someone must have been in the server to use public commands (e.g. 24h). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "0s",
+		},
+		{
+			Key:         config.KeyAccountAgeGateExemptCmds,
+			Category:    config.CategoryMisc,
+			Label:       "Age gate exempt commands",
+			Description: "Comma-separated commands new accounts can always use (e.g. help,ping).",
+			Kind:        config.KindString,
+			Default:     "help",
+		},
+		{
+			Key:         config.KeyExtraSuperAdminIDs,
+			Category:    config.CategoryMisc,
+			Label:       "Extra super admins",
+			Description: "Users with super admin access on top of the bootstrap super_admins list. Primary server only; only bootstrap super admins can change it.",
+			Kind:        config.KindUserList,
+		},
 	}
 }
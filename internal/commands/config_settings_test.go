@@ -8,7 +8,9 @@ import (
 	"gamerpal/internal/commands/modules/intro"
 	"gamerpal/internal/commands/modules/lfg"
 	nineteeneightyfour "gamerpal/internal/commands/modules/nineteeneightyfour"
+	"gamerpal/internal/commands/modules/prune"
 	"gamerpal/internal/commands/modules/scamguard"
+	"gamerpal/internal/commands/modules/userstats"
 	"gamerpal/internal/commands/modules/welcome"
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
@@ -30,6 +32,8 @@ func realProviderHandler() *ModuleHandler {
 			"1984":         &nineteeneightyfour.Module{},
 			"fun":          &fun.Module{},
 			"agentadapter": &agentadapter.Module{},
+			"prune":        &prune.Module{},
+			"userstats":    &userstats.Module{},
 		},
 	}
 }
@@ -44,14 +48,42 @@ func TestCollectConfigSettingsCoversAllKeys(t *testing.T) {
 		config.KeyVoiceSyncCategoryID,
 		config.KeyHelpDeskChannelID,
 		config.KeyEventFeedChannelID,
+		config.KeyAccountAgeGateEnabled,
+		config.KeyAccountAgeGateMinAccountAge,
+		config.KeyAccountAgeGateMinMemberAge,
+		config.KeyAccountAgeGateExemptCmds,
+		config.KeyExtraSuperAdminIDs,
+		config.KeyPruneProtectedRoleIDs,
+		config.KeyPruneCountedRoleIDs,
+		config.KeyPruneReportEnabled,
+		config.KeyPruneReportInterval,
+		config.KeyPruneCooldown,
+		config.KeyUserStatsCooldown,
 		config.KeyIntroductionsForumChannelID,
 		config.KeyIntroFeedChannelID,
 		config.KeyIntroFeedRateLimitHours,
 		config.KeyIntroFeedBoosterRateLimit,
+		config.KeyIntroLegacyChannelID,
+		config.KeyIntroGreeterChannelID,
+		config.KeyIntroGreeterRoleID,
+		config.KeyIntroGreeterDebounce,
 		config.KeyLFGForumChannelID,
+		config.KeyLFGExtraForumIDs,
 		config.KeyLFGNowPanelChannelID,
 		config.KeyLFGNowRoleID,
 		config.KeyLFGNowRoleDuration,
+		config.KeyLFGPingRoleID,
+		config.KeyLFGPingCooldown,
+		config.KeyLFGNowPostExpiry,
+		config.KeyLFGVoiceCategoryID,
+		config.KeyLFGVoiceEmptyGrace,
+		config.KeyLFGThreadCreateLimit,
+		config.KeyLFGThreadCreateWin,
+		config.KeyLFGGameThreadCooldown,
+		config.KeyLFGRefreshCacheCooldown,
+		config.KeyLFGMaxSuggestions,
+		config.KeyLFGThreadAutoArchive,
+		config.KeyLFGPinStarterMessage,
 		config.KeyNewPalsSystemEnabled,
 		config.KeyNewPalsRoleID,
 		config.KeyNewPalsChannelID,
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"gamerpal/internal/commands/types"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// cooldownPruneInterval is how often expired cooldown entries are dropped.
+const cooldownPruneInterval = 10 * time.Minute
+
+// cooldownTracker enforces types.Cooldown in memory. Entries map a
+// command/scope key to the time the command may next run.
+type cooldownTracker struct {
+	mu        sync.Mutex
+	until     map[string]time.Time
+	lastPrune time.Time
+}
+
+func newCooldownTracker() *cooldownTracker {
+	return &cooldownTracker{until: make(map[string]time.Time)}
+}
+
+// cooldownKey builds the tracker key for a command run.
+func cooldownKey(name string, scope types.CooldownScope, guildID, userID string) string {
+	if scope == types.CooldownPerGuild {
+		return fmt.Sprintf("%s:guild:%s", name, guildID)
+	}
+	return fmt.Sprintf("%s:user:%s", name, userID)
+}
+
+// take reports how long the caller must still wait. When the wait is zero the
+// run is allowed and a cooldown of window starts from now. A window <= 0
+// never throttles.
+func (t *cooldownTracker) take(name string, scope types.CooldownScope, window time.Duration, guildID, userID string, now time.Time) time.Duration {
+	if window <= 0 {
+		return 0
+	}
+	key := cooldownKey(name, scope, guildID, userID)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Sub(t.lastPrune) >= cooldownPruneInterval {
+		t.prune(now)
+	}
+	if until, ok := t.until[key]; ok && now.Before(until) {
+		return until.Sub(now)
+	}
+	t.until[key] = now.Add(window)
+	return 0
+}
+
+// prune drops expired entries. Callers must hold t.mu.
+func (t *cooldownTracker) prune(now time.Time) {
+	for key, until := range t.until {
+		if !now.Before(until) {
+			delete(t.until, key)
+		}
+	}
+	t.lastPrune = now
+}
+
+// cooldownMessage is the ephemeral reply for a throttled command. name may
+// include a subcommand, e.g. "lfg-admin refresh-thread-cache".
+func cooldownMessage(name string, scope types.CooldownScope, wait time.Duration) string {
+	wait = max(wait.Round(time.Second), time.Second)
+	if scope == types.CooldownPerGuild {
+		return fmt.Sprintf("⏳ /%s was run recently in this server. Try again in %s.", name, wait)
+	}
+	return fmt.Sprintf("⏳ You're using /%s too quickly. Try again in %s.", name, wait)
+}
+
+// applicableCooldown picks the cooldown for an invocation of cmd: the invoked
+// subcommand's own cooldown when it has one, otherwise the command's. It
+// returns the name the cooldown is tracked and reported under.
+func applicableCooldown(name string, cmd *types.Command, data discordgo.ApplicationCommandInteractionData) (string, *types.Cooldown) {
+	if len(data.Options) > 0 && data.Options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		sub := data.Options[0].Name
+		if cd, ok := cmd.SubcommandCooldowns[sub]; ok {
+			return name + " " + sub, cd
+		}
+	}
+	return name, cmd.Cooldown
+}
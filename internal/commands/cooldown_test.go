@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"gamerpal/internal/commands/types"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCooldownTracker(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("per user", func(t *testing.T) {
+		tr := newCooldownTracker()
+		take := func(name, userID string, at time.Time) time.Duration {
+			return tr.take(name, types.CooldownPerUser, 30*time.Second, "g1", userID, at)
+		}
+
+		assert.Zero(t, take("userstats", "u1", now))
+		assert.Equal(t, 20*time.Second, take("userstats", "u1", now.Add(10*time.Second)))
+		// Other users and other commands are unaffected.
+		assert.Zero(t, take("userstats", "u2", now))
+		assert.Zero(t, take("metrics", "u1", now))
+		// The window elapses.
+		assert.Zero(t, take("userstats", "u1", now.Add(30*time.Second)))
+	})
+
+	t.Run("per guild", func(t *testing.T) {
+		tr := newCooldownTracker()
+		take := func(guildID, userID string, at time.Time) time.Duration {
+			return tr.take("prune-forum", types.CooldownPerGuild, 2*time.Minute, guildID, userID, at)
+		}
+
+		assert.Zero(t, take("g1", "u1", now))
+		// A different user in the same guild shares the cooldown.
+		assert.Equal(t, time.Minute, take("g1", "u2", now.Add(time.Minute)))
+		assert.Zero(t, take("g2", "u2", now.Add(time.Minute)))
+	})
+
+	t.Run("expired entries are pruned", func(t *testing.T) {
+		tr := newCooldownTracker()
+		tr.take("ping", types.CooldownPerUser, time.Second, "g1", "u1", now)
+		tr.take("ping", types.CooldownPerUser, time.Second, "g1", "u2", now.Add(cooldownPruneInterval))
+		assert.Len(t, tr.until, 1)
+	})
+
+	t.Run("disabled window", func(t *testing.T) {
+		tr := newCooldownTracker()
+		assert.Zero(t, tr.take("ping", types.CooldownPerUser, 0, "g1", "u1", now))
+		assert.Zero(t, tr.take("ping", types.CooldownPerUser, 0, "g1", "u1", now))
+	})
+}
+
+func TestApplicableCooldown(t *testing.T) {
+	cmdCD := &types.Cooldown{Scope: types.CooldownPerUser}
+	refreshCD := &types.Cooldown{Scope: types.CooldownPerGuild}
+	cmd := &types.Command{
+		Cooldown:            cmdCD,
+		SubcommandCooldowns: map[string]*types.Cooldown{"refresh-thread-cache": refreshCD},
+	}
+	sub := func(name string) discordgo.ApplicationCommandInteractionData {
+		return discordgo.ApplicationCommandInteractionData{Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Type: discordgo.ApplicationCommandOptionSubCommand, Name: name},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		data     discordgo.ApplicationCommandInteractionData
+		wantName string
+		wantCD   *types.Cooldown
+	}{
+		{name: "no subcommand", wantName: "lfg-admin", wantCD: cmdCD},
+		{name: "subcommand with its own cooldown", data: sub("refresh-thread-cache"), wantName: "lfg-admin refresh-thread-cache", wantCD: refreshCD},
+		{name: "other subcommand", data: sub("trending"), wantName: "lfg-admin", wantCD: cmdCD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, cd := applicableCooldown("lfg-admin", cmd, tt.data)
+			assert.Equal(t, tt.wantName, name)
+			assert.Same(t, tt.wantCD, cd)
+		})
+	}
+}
+
+func TestCooldownMessage(t *testing.T) {
+	assert.Equal(t, "⏳ You're using /userstats too quickly. Try again in 12s.",
+		cooldownMessage("userstats", types.CooldownPerUser, 11600*time.Millisecond))
+	assert.Equal(t, "⏳ /prune-forum was run recently in this server. Try again in 1m30s.",
+		cooldownMessage("prune-forum", types.CooldownPerGuild, 90*time.Second))
+	assert.Equal(t, "⏳ /lfg-admin refresh-thread-cache was run recently in this server. Try again in 5m0s.",
+		cooldownMessage("lfg-admin refresh-thread-cache", types.CooldownPerGuild, 5*time.Minute))
+}
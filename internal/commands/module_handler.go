@@ -1,50 +1,93 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"gamerpal/internal/commands/modules/agentadapter"
 	"gamerpal/internal/commands/modules/ban"
 	"gamerpal/internal/commands/modules/config"
+	"gamerpal/internal/commands/modules/dbbackup"
 	"gamerpal/internal/commands/modules/fetchintros"
+	"gamerpal/internal/commands/modules/forums"
 	"gamerpal/internal/commands/modules/fun"
 	"gamerpal/internal/commands/modules/help"
 	"gamerpal/internal/commands/modules/intro"
 	"gamerpal/internal/commands/modules/lfg"
+	"gamerpal/internal/commands/modules/metrics"
 	nineteeneightyfour "gamerpal/internal/commands/modules/nineteeneightyfour"
 	"gamerpal/internal/commands/modules/ping"
 	"gamerpal/internal/commands/modules/poll"
 	"gamerpal/internal/commands/modules/prune"
 	"gamerpal/internal/commands/modules/refreshigdb"
+	"gamerpal/internal/commands/modules/report"
 	"gamerpal/internal/commands/modules/say"
 	"gamerpal/internal/commands/modules/scamguard"
 	"gamerpal/internal/commands/modules/status"
+	"gamerpal/internal/commands/modules/timezone"
 	"gamerpal/internal/commands/modules/userstats"
 	"gamerpal/internal/commands/modules/welcome"
+	"gamerpal/internal/commands/modules/whois"
 	"gamerpal/internal/commands/types"
 	internalConfig "gamerpal/internal/config"
 	"gamerpal/internal/database"
 	"gamerpal/internal/forumcache"
+	"gamerpal/internal/games"
+	"gamerpal/internal/telemetry"
+	"gamerpal/internal/utils"
+	"runtime/debug"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Henry-Sarabia/igdb/v2"
 	"github.com/bwmarrin/discordgo"
 )
 
+// Hooks used when recovering from a handler panic. Overridable in tests.
+var (
+	panicRespond = func(s *discordgo.Session, i *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+		return s.InteractionRespond(i, resp)
+	}
+	panicFollowup = func(s *discordgo.Session, i *discordgo.Interaction, params *discordgo.WebhookParams) error {
+		_, err := s.FollowupMessageCreate(i, true, params)
+		return err
+	}
+	panicLogToChannel = func(cfg *internalConfig.Config, s *discordgo.Session, msg string) error {
+		return utils.LogToChannel(cfg, s, msg)
+	}
+)
+
+// panicUserMessage is shown to the user when their command's handler panics.
+const panicUserMessage = "❌ Something went wrong while running that command. The team has been notified."
+
 // ModuleHandler manages command modules, routing interactions and exposing select modules externally.
 type ModuleHandler struct {
-	commands   map[string]*types.Command
-	modules    map[string]types.CommandModule
-	config     *internalConfig.Config
-	db         *database.DB
-	deps       *types.Dependencies
-	igdbClient *igdb.Client
+	commands map[string]*types.Command
+	modules  map[string]types.CommandModule
+	// commandOwners maps each command name to the module that registered it.
+	commandOwners map[string]string
+	cooldowns     *cooldownTracker
+	// commandsTotal and commandPanics are nil (no-op) unless EnableTelemetry ran.
+	commandsTotal *telemetry.CounterVec
+	commandPanics *telemetry.CounterVec
+	config        *internalConfig.Config
+	db            *database.DB
+	deps          *types.Dependencies
+	igdbClient    *igdb.Client
 }
 
 // NewModuleHandler creates a new module-based command handler
 func NewModuleHandler(cfg *internalConfig.Config, session *discordgo.Session) *ModuleHandler {
-	igdbClient := igdb.NewClient(cfg.GetIGDBClientID(), cfg.GetIGDBClientToken(), nil)
-
-	db, err := database.NewDB(cfg.GetDatabasePath())
+	// Requests go through the token manager's HTTP client, so a token refresh
+	// reaches every module holding this client.
+	igdbTokens := games.NewTokenManager(cfg.GetIGDBClientID(), cfg.GetIGDBClientSecret(), cfg.GetIGDBClientToken())
+	igdbClient := igdb.NewClient(cfg.GetIGDBClientID(), cfg.GetIGDBClientToken(), igdbTokens.HTTPClient())
+
+	db, err := database.NewDBWithOptions(cfg.GetDatabasePath(), database.Options{
+		BusyTimeout: cfg.GetDatabaseBusyTimeout(),
+		WAL:         cfg.GetDatabaseWAL(),
+	})
 	if err != nil {
 		// A nil database silently breaks every persistence-backed module
 		// (scamguard, intros, welcome). Fail loudly instead of
@@ -62,15 +105,18 @@ func NewModuleHandler(cfg *internalConfig.Config, session *discordgo.Session) *M
 	}
 
 	h := &ModuleHandler{
-		commands:   make(map[string]*types.Command),
-		modules:    make(map[string]types.CommandModule),
-		config:     cfg,
-		db:         db,
-		igdbClient: igdbClient,
+		commands:      make(map[string]*types.Command),
+		modules:       make(map[string]types.CommandModule),
+		commandOwners: make(map[string]string),
+		cooldowns:     newCooldownTracker(),
+		config:        cfg,
+		db:            db,
+		igdbClient:    igdbClient,
 		deps: &types.Dependencies{
 			Config:     cfg,
 			DB:         db,
 			IGDBClient: igdbClient,
+			IGDBTokens: igdbTokens,
 			Session:    session,
 			ForumCache: fc,
 		},
@@ -105,22 +151,48 @@ func (h *ModuleHandler) registerModules() {
 		{"fun", fun.New(h.deps)},
 		{"1984", nineteeneightyfour.New(h.deps)},
 		{"scamguard", scamguard.New(h.deps)},
+		{"report", report.New(h.deps)},
+		{"metrics", metrics.New(h.deps)},
+		{"dbbackup", dbbackup.New(h.deps)},
 		{"agentadapter", agentadapter.New(h.deps)},
+		{"whois", whois.New(h.deps)},
+		{"timezone", timezone.New(h.deps)},
+		{"forums", forums.New(h.deps)},
 	}
 
 	for _, m := range modules {
-		// Special handling for refreshigdb module to update IGDB client
-		if m.name == "refreshigdb" {
-			if rm, ok := m.module.(*refreshigdb.Module); ok {
-				rm.SetIGDBClientRef(&h.igdbClient)
-			}
+		cmds := make(map[string]*types.Command)
+		m.module.Register(cmds, h.deps)
+		if err := h.mergeModuleCommands(m.name, cmds); err != nil {
+			h.config.Logger.Error(err.Error())
 		}
-
-		m.module.Register(h.commands, h.deps)
 		h.modules[m.name] = m.module
 	}
 }
 
+// mergeModuleCommands adds the commands a module registered to the handler.
+// A name already claimed by an earlier module is skipped and reported, so the
+// first registrant in registerModules order always wins and two handlers never
+// silently fight over one command.
+func (h *ModuleHandler) mergeModuleCommands(module string, cmds map[string]*types.Command) error {
+	names := make([]string, 0, len(cmds))
+	for name := range cmds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if owner, taken := h.commandOwners[name]; taken {
+			errs = append(errs, fmt.Errorf("duplicate command /%s: registered by %q and %q, keeping %q", name, owner, module, owner))
+			continue
+		}
+		h.commands[name] = cmds[name]
+		h.commandOwners[name] = module
+	}
+	return errors.Join(errs...)
+}
+
 // GetModule returns a module by name with type assertion.
 // This is used for external access (scheduler, bot event handlers).
 //
@@ -142,6 +214,10 @@ func (h *ModuleHandler) GetForumCache() *forumcache.Service { return h.deps.Foru
 // RegisterCommands registers all slash commands with Discord using a single bulk overwrite call.
 // BulkOverwrite replaces the full command set atomically — any commands not in the list
 // (including development-only commands) are automatically removed by Discord.
+//
+// When dev_guild_id is set, commands are registered to that guild only, which
+// propagates instantly. Otherwise they are registered globally and any
+// guild-scoped commands left over from development are removed.
 func (h *ModuleHandler) RegisterCommands(s *discordgo.Session) error {
 	// Collect all production commands for a single bulk overwrite.
 	var cmds []*discordgo.ApplicationCommand
@@ -151,7 +227,8 @@ func (h *ModuleHandler) RegisterCommands(s *discordgo.Session) error {
 		}
 	}
 
-	registered, err := s.ApplicationCommandBulkOverwrite(s.State.User.ID, "", cmds)
+	guildID := h.config.GetDevGuildID()
+	registered, err := s.ApplicationCommandBulkOverwrite(s.State.User.ID, guildID, cmds)
 	if err != nil {
 		return fmt.Errorf("bulk command registration failed: %w", err)
 	}
@@ -162,11 +239,37 @@ func (h *ModuleHandler) RegisterCommands(s *discordgo.Session) error {
 			c.ApplicationCommand.ID = rc.ID
 		}
 	}
+	if guildID != "" {
+		h.config.Logger.Infof("Registered %d commands to dev guild %s (bulk overwrite)", len(registered), guildID)
+		return nil
+	}
 	h.config.Logger.Infof("Registered %d commands (bulk overwrite)", len(registered))
 
+	h.clearGuildCommands(s)
 	return nil
 }
 
+// clearGuildCommands removes guild-scoped commands from every guild the bot is
+// in, so switching from dev_guild_id back to global registration doesn't leave
+// each command listed twice in the former dev guild.
+func (h *ModuleHandler) clearGuildCommands(s *discordgo.Session) {
+	for _, g := range s.State.Guilds {
+		existing, err := s.ApplicationCommands(s.State.User.ID, g.ID)
+		if err != nil {
+			h.config.Logger.Warnf("Error fetching guild commands for %s: %v", g.ID, err)
+			continue
+		}
+		if len(existing) == 0 {
+			continue
+		}
+		if _, err := s.ApplicationCommandBulkOverwrite(s.State.User.ID, g.ID, []*discordgo.ApplicationCommand{}); err != nil {
+			h.config.Logger.Warnf("Error clearing guild commands for %s: %v", g.ID, err)
+			continue
+		}
+		h.config.Logger.Infof("Cleared %d leftover guild commands from %s", len(existing), g.ID)
+	}
+}
+
 // HandleInteraction routes slash command interactions to appropriate handlers
 func (h *ModuleHandler) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if i.ApplicationCommandData().Name == "" {
@@ -175,10 +278,109 @@ func (h *ModuleHandler) HandleInteraction(s *discordgo.Session, i *discordgo.Int
 
 	commandName := i.ApplicationCommandData().Name
 	if cmd, exists := h.commands[commandName]; exists {
+		if reason := accountAgeGateReason(h.config.ForGuild(i.GuildID), commandName, cmd, i, time.Now()); reason != "" {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Content: reason, Flags: discordgo.MessageFlagsEphemeral},
+			})
+			return
+		}
+		if name, scope, wait := h.cooldownWait(commandName, cmd, i); wait > 0 {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Content: cooldownMessage(name, scope, wait), Flags: discordgo.MessageFlagsEphemeral},
+			})
+			return
+		}
+
+		// A panicking handler leaves succeeded false, so it is recorded as a panic.
+		succeeded := false
+		defer func() { h.recordCommandUsage(commandName, i, succeeded) }()
+		defer h.recoverCommandPanic(s, i, commandName)
 		cmd.HandlerFunc(s, i)
+		succeeded = true
 	}
 }
 
+// cooldownWait returns how long the invoker must wait before cmd (or the
+// invoked subcommand) may run again, along with the name and scope of the
+// cooldown that applied. The cooldown starts when it may run now, using the
+// guild's configured window. Administrators bypass.
+func (h *ModuleHandler) cooldownWait(name string, cmd *types.Command, i *discordgo.InteractionCreate) (string, types.CooldownScope, time.Duration) {
+	name, cd := applicableCooldown(name, cmd, i.ApplicationCommandData())
+	if h.cooldowns == nil || cd == nil || cd.Window == nil {
+		return name, 0, 0
+	}
+	userID := ""
+	switch {
+	case i.Member != nil && i.Member.User != nil:
+		if i.Member.Permissions&discordgo.PermissionAdministrator != 0 {
+			return name, cd.Scope, 0
+		}
+		userID = i.Member.User.ID
+	case i.User != nil:
+		userID = i.User.ID
+	}
+	window := cd.Window(h.config.ForGuild(i.GuildID))
+	return name, cd.Scope, h.cooldowns.take(name, cd.Scope, window, i.GuildID, userID, time.Now())
+}
+
+// recoverCommandPanic keeps a panicking command handler from taking down the
+// bot. It logs the panic with a stack trace, posts a short alert to the log
+// channel, and tells the user something went wrong. Must be deferred directly.
+func (h *ModuleHandler) recoverCommandPanic(s *discordgo.Session, i *discordgo.InteractionCreate, commandName string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	h.config.Logger.Errorf("panic in /%s handler: %v\n%s", commandName, r, debug.Stack())
+
+	// The stack trace stays in the process log; the channel alert only carries
+	// enough to find it.
+	detail := fmt.Sprint(r)
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+	alert := fmt.Sprintf("[CommandPanic]\nCommand: /%s\nGuild: %s\nChannel: %s\nError: %s", commandName, i.GuildID, i.ChannelID, detail)
+	if err := panicLogToChannel(h.config, s, alert); err != nil {
+		h.config.Logger.Warnf("Failed to post command panic alert: %v", err)
+	}
+
+	// The handler may already have responded (e.g. deferred), in which case
+	// only a followup can reach the user.
+	err := panicRespond(s, i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: panicUserMessage, Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		_ = panicFollowup(s, i.Interaction, &discordgo.WebhookParams{Content: panicUserMessage, Flags: discordgo.MessageFlagsEphemeral})
+	}
+}
+
+// recordCommandUsage stores a command invocation for /metrics. success is
+// false only when the handler panicked. The write runs in the background so a
+// slow or failing database never delays command handling.
+func (h *ModuleHandler) recordCommandUsage(commandName string, i *discordgo.InteractionCreate, success bool) {
+	h.commandsTotal.Inc(commandName)
+	if !success {
+		h.commandPanics.Inc(commandName)
+	}
+	if h.db == nil {
+		return
+	}
+	userID := ""
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	} else if i.User != nil {
+		userID = i.User.ID
+	}
+	go func() {
+		if err := h.db.RecordCommandUsage(commandName, i.GuildID, userID, success); err != nil {
+			h.config.Logger.Warnf("Failed to record command usage: %v", err)
+		}
+	}()
+}
+
 // HandleComponentInteraction routes component interactions to appropriate module handlers
 func (h *ModuleHandler) HandleComponentInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	cid := i.MessageComponentData().CustomID
@@ -196,6 +398,18 @@ func (h *ModuleHandler) HandleComponentInteraction(s *discordgo.Session, i *disc
 		} else {
 			h.config.Logger.Warn("Config interaction received but config module not available")
 		}
+	case strings.HasPrefix(cid, "report:"):
+		if reportMod, ok := h.GetModule("report").(*report.Module); ok {
+			reportMod.HandleComponent(s, i)
+		} else {
+			h.config.Logger.Warn("Report interaction received but report module not available")
+		}
+	case strings.HasPrefix(cid, "say:"):
+		if sayMod, ok := h.GetModule("say").(*say.Module); ok {
+			sayMod.HandleComponent(s, i)
+		} else {
+			h.config.Logger.Warn("Say interaction received but say module not available")
+		}
 	default:
 		// LFG module handles all other component interactions
 		if lfgMod, ok := h.GetModule("lfg").(*lfg.Module); ok {
@@ -216,6 +430,14 @@ func (h *ModuleHandler) HandleModalSubmit(s *discordgo.Session, i *discordgo.Int
 		}
 		return
 	}
+	if strings.HasPrefix(i.ModalSubmitData().CustomID, "report:") {
+		if reportMod, ok := h.GetModule("report").(*report.Module); ok {
+			reportMod.HandleModalSubmit(s, i)
+		} else {
+			h.config.Logger.Warn("Report modal submit received but report module not available")
+		}
+		return
+	}
 	// Otherwise the LFG module handles modal submissions
 	if lfgMod, ok := h.GetModule("lfg").(*lfg.Module); ok {
 		lfgMod.HandleModalSubmit(s, i)
@@ -257,7 +479,8 @@ func (h *ModuleHandler) HandleReactionRemove(s *discordgo.Session, r *discordgo.
 
 // UnregisterCommands removes all registered commands
 func (h *ModuleHandler) UnregisterCommands(s *discordgo.Session) {
-	existingCommands, err := s.ApplicationCommands(s.State.User.ID, "")
+	guildID := h.config.GetDevGuildID()
+	existingCommands, err := s.ApplicationCommands(s.State.User.ID, guildID)
 	if err != nil {
 		h.config.Logger.Warn("Error fetching existing commands: %v", err)
 		return
@@ -265,7 +488,7 @@ func (h *ModuleHandler) UnregisterCommands(s *discordgo.Session) {
 
 	for _, existingCmd := range existingCommands {
 		if _, exists := h.commands[existingCmd.Name]; exists {
-			err := s.ApplicationCommandDelete(s.State.User.ID, "", existingCmd.ID)
+			err := s.ApplicationCommandDelete(s.State.User.ID, guildID, existingCmd.ID)
 			if err != nil {
 				h.config.Logger.Warn("Error deleting command %s: %v", existingCmd.Name, err)
 			} else {
@@ -295,6 +518,21 @@ func (h *ModuleHandler) InitializeModuleServices(s *discordgo.Session) error {
 	return nil
 }
 
+// ShutdownModuleServices gives every service implementing
+// types.ShutdownService a chance to flush transient state. Errors are logged so
+// one failing service doesn't prevent the others from shutting down.
+func (h *ModuleHandler) ShutdownModuleServices(ctx context.Context) {
+	for name, module := range h.modules {
+		service, ok := module.Service().(types.ShutdownService)
+		if !ok {
+			continue
+		}
+		if err := service.Shutdown(ctx); err != nil {
+			h.config.Logger.Errorf("Failed to shut down %s service: %v", name, err)
+		}
+	}
+}
+
 // RegisterModuleSchedulers registers the recurring tasks declared by every
 // module's service with the scheduler. Called after services are initialized.
 func (h *ModuleHandler) RegisterModuleSchedulers(scheduler interface {
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"gamerpal/internal/commands/types"
+	internalConfig "gamerpal/internal/config"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleInteractionRecoversFromPanic(t *testing.T) {
+	origRespond, origFollowup, origLog := panicRespond, panicFollowup, panicLogToChannel
+	t.Cleanup(func() { panicRespond, panicFollowup, panicLogToChannel = origRespond, origFollowup, origLog })
+
+	var responses []*discordgo.InteractionResponse
+	var followups []*discordgo.WebhookParams
+	var alerts []string
+	respondErr := error(nil)
+	panicRespond = func(_ *discordgo.Session, _ *discordgo.Interaction, resp *discordgo.InteractionResponse) error {
+		responses = append(responses, resp)
+		return respondErr
+	}
+	panicFollowup = func(_ *discordgo.Session, _ *discordgo.Interaction, params *discordgo.WebhookParams) error {
+		followups = append(followups, params)
+		return nil
+	}
+	panicLogToChannel = func(_ *internalConfig.Config, _ *discordgo.Session, msg string) error {
+		alerts = append(alerts, msg)
+		return nil
+	}
+
+	h := &ModuleHandler{
+		commands: map[string]*types.Command{
+			"boom": {
+				ApplicationCommand: &discordgo.ApplicationCommand{Name: "boom"},
+				HandlerFunc: func(_ *discordgo.Session, i *discordgo.InteractionCreate) {
+					_ = i.Member.User.ID // nil Member, as in a DM
+				},
+			},
+		},
+		config: internalConfig.NewMockConfig(map[string]any{}),
+	}
+	inter := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionApplicationCommand,
+		GuildID: "g1",
+		Data:    discordgo.ApplicationCommandInteractionData{Name: "boom"},
+	}}
+
+	require.NotPanics(t, func() { h.HandleInteraction(&discordgo.Session{}, inter) })
+	require.Len(t, alerts, 1)
+	assert.Contains(t, alerts[0], "Command: /boom")
+	assert.Contains(t, alerts[0], "nil pointer")
+	assert.NotContains(t, alerts[0], "goroutine", "stack trace must not be posted to the channel")
+	require.Len(t, responses, 1)
+	assert.Equal(t, panicUserMessage, responses[0].Data.Content)
+	assert.Equal(t, discordgo.MessageFlagsEphemeral, responses[0].Data.Flags)
+	assert.Empty(t, followups)
+
+	// When the handler already responded, the user is reached via followup.
+	respondErr = errors.New("already acknowledged")
+	require.NotPanics(t, func() { h.HandleInteraction(&discordgo.Session{}, inter) })
+	require.Len(t, followups, 1)
+	assert.Equal(t, panicUserMessage, followups[0].Content)
+}
+
+func TestMergeModuleCommandsRejectsDuplicates(t *testing.T) {
+	h := &ModuleHandler{
+		commands:      make(map[string]*types.Command),
+		commandOwners: make(map[string]string),
+	}
+	first := &types.Command{ApplicationCommand: &discordgo.ApplicationCommand{Name: "say"}}
+	second := &types.Command{ApplicationCommand: &discordgo.ApplicationCommand{Name: "say"}}
+
+	require.NoError(t, h.mergeModuleCommands("say", map[string]*types.Command{"say": first}))
+
+	err := h.mergeModuleCommands("legacy", map[string]*types.Command{
+		"say":  second,
+		"ping": {ApplicationCommand: &discordgo.ApplicationCommand{Name: "ping"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/say")
+	assert.Contains(t, err.Error(), `"say"`)
+	assert.Contains(t, err.Error(), `"legacy"`)
+
+	// The first registrant is kept; non-conflicting commands still register.
+	assert.Same(t, first, h.commands["say"])
+	assert.Equal(t, "say", h.commandOwners["say"])
+	assert.Contains(t, h.commands, "ping")
+}
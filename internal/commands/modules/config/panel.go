@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"gamerpal/internal/config"
+	"gamerpal/internal/utils"
 	"strconv"
 	"strings"
 
@@ -220,7 +221,14 @@ func (m *Module) handlePick(s *discordgo.Session, i *discordgo.InteractionCreate
 		respondEphemeral(s, i, "❌ Unknown setting. Run /config again.")
 		return
 	}
+	// Ban Members is enough to open the panel, so granting super admin is
+	// limited to the bootstrap list to stop mods promoting themselves.
+	if key == config.KeyExtraSuperAdminIDs && !m.config.IsBootstrapSuperAdmin(interactionUserID(i)) {
+		respondEphemeral(s, i, "❌ Only super admins from the bot's super_admins config can change this.")
+		return
+	}
 	gc := m.config.ForGuild(i.GuildID)
+	before, _ := effectiveRaw(gc, st)
 	vals := i.MessageComponentData().Values
 	var note string
 	if len(vals) == 0 {
@@ -228,18 +236,18 @@ func (m *Module) handlePick(s *discordgo.Session, i *discordgo.InteractionCreate
 			m.config.Logger.Warnf("config panel: clear %s: %v", key, err)
 			note = "❌ Could not clear " + st.Label
 		} else {
-			note = "✅ Cleared " + st.Label
+			note = "✅ Cleared " + m.recordChanges(s, i, gc, changeFor(gc, st, before))
 		}
 	} else {
 		raw := vals[0]
-		if st.Kind == config.KindChannelList {
+		if st.Kind == config.KindChannelList || st.Kind == config.KindRoleList || st.Kind == config.KindUserList {
 			raw = strings.Join(vals, ",")
 		}
 		if err := gc.SetOverride(key, raw, interactionUserID(i)); err != nil {
 			m.config.Logger.Warnf("config panel: set %s: %v", key, err)
 			note = "❌ Could not save " + st.Label
 		} else {
-			note = "✅ Saved " + st.Label
+			note = "✅ Saved " + m.recordChanges(s, i, gc, changeFor(gc, st, before))
 		}
 	}
 	m.updateMessage(s, i, m.renderCategory(i.GuildID, st.Category, note))
@@ -254,11 +262,14 @@ func (m *Module) handleToggle(s *discordgo.Session, i *discordgo.InteractionCrea
 		return
 	}
 	gc := m.config.ForGuild(i.GuildID)
+	before, _ := effectiveRaw(gc, st)
 	next := !effBool(gc, key)
 	note := "✅ " + st.Label + " " + onOff(next)
 	if err := gc.SetOverride(key, strconv.FormatBool(next), interactionUserID(i)); err != nil {
 		m.config.Logger.Warnf("config panel: toggle %s: %v", key, err)
 		note = "❌ Could not update " + st.Label
+	} else {
+		m.recordChanges(s, i, gc, changeFor(gc, st, before))
 	}
 	m.updateMessage(s, i, m.renderCategory(i.GuildID, st.Category, note))
 }
@@ -311,7 +322,8 @@ func (m *Module) handleEditModalSubmit(s *discordgo.Session, i *discordgo.Intera
 	gc := m.config.ForGuild(i.GuildID)
 	userID := interactionUserID(i)
 
-	var saved, errs []string
+	var changes []settingChange
+	var errs []string
 	for _, comp := range i.ModalSubmitData().Components {
 		var row *discordgo.ActionsRow
 		switch v := comp.(type) {
@@ -331,11 +343,14 @@ func (m *Module) handleEditModalSubmit(s *discordgo.Session, i *discordgo.Intera
 			if !ok {
 				continue
 			}
+			before, _ := effectiveRaw(gc, st)
 			raw := strings.TrimSpace(ti.Value)
 			if raw == "" {
 				if err := gc.ClearOverride(st.Key); err != nil {
 					m.config.Logger.Warnf("config panel: clear %s: %v", st.Key, err)
+					continue
 				}
+				changes = append(changes, changeFor(gc, st, before))
 				continue
 			}
 			if err := config.ValidateValue(st, raw); err != nil {
@@ -347,13 +362,13 @@ func (m *Module) handleEditModalSubmit(s *discordgo.Session, i *discordgo.Intera
 				errs = append(errs, fmt.Sprintf("%s: could not save", st.Label))
 				continue
 			}
-			saved = append(saved, st.Label)
+			changes = append(changes, changeFor(gc, st, before))
 		}
 	}
 
 	note := "✅ Saved"
-	if len(saved) > 0 {
-		note = "✅ Saved: " + strings.Join(saved, ", ")
+	if summary := m.recordChanges(s, i, gc, changes...); summary != "" {
+		note = "✅ Saved: " + summary
 	}
 	if len(errs) > 0 {
 		note += "\n⚠️ " + strings.Join(errs, "; ")
@@ -371,6 +386,51 @@ func (m *Module) updateMessage(s *discordgo.Session, i *discordgo.InteractionCre
 	}
 }
 
+// settingChange is one setting's effective value before and after an edit.
+type settingChange struct {
+	Setting       config.Setting
+	Before, After string
+}
+
+// changeFor pairs a setting's value read before an edit with its value now.
+func changeFor(gc *config.GuildConfig, st config.Setting, before string) settingChange {
+	after, _ := effectiveRaw(gc, st)
+	return settingChange{Setting: st, Before: before, After: after}
+}
+
+// String renders the change as "Label: old → new".
+func (c settingChange) String() string {
+	return fmt.Sprintf("%s: %s → %s", c.Setting.Label, formatValue(c.Setting, c.Before), formatValue(c.Setting, c.After))
+}
+
+// recordChanges logs the changes that actually altered a value to the bot log
+// channel, with who made them, and returns them joined for the panel note.
+// Saves that leave the value as it was are neither shown nor logged. The log
+// post runs in the background: callers answer the interaction afterwards, and
+// a slow post would otherwise fail the panel click.
+func (m *Module) recordChanges(s *discordgo.Session, i *discordgo.InteractionCreate, gc *config.GuildConfig, changes ...settingChange) string {
+	var lines []string
+	for _, c := range changes {
+		if strings.TrimSpace(c.Before) == strings.TrimSpace(c.After) {
+			continue
+		}
+		lines = append(lines, c.String())
+	}
+	if len(lines) == 0 {
+		if len(changes) == 1 {
+			return changes[0].Setting.Label + " (unchanged)"
+		}
+		return ""
+	}
+	logMsg := fmt.Sprintf("<@%s> changed config for guild `%s`:\n%s", interactionUserID(i), gc.GuildID(), strings.Join(lines, "\n"))
+	go func() {
+		if err := utils.LogToChannel(m.config, s, logMsg); err != nil {
+			m.config.Logger.Warnf("config panel: log change: %v", err)
+		}
+	}()
+	return strings.Join(lines, "; ")
+}
+
 // ---- value helpers ----
 
 // effectiveRaw returns the value in effect for a setting (override else
@@ -417,6 +477,18 @@ func formatValue(st config.Setting, raw string) string {
 			parts[idx] = "<#" + p + ">"
 		}
 		return strings.Join(parts, ", ")
+	case config.KindRoleList:
+		parts := splitCSV(raw)
+		for idx, p := range parts {
+			parts[idx] = "<@&" + p + ">"
+		}
+		return strings.Join(parts, ", ")
+	case config.KindUserList:
+		parts := splitCSV(raw)
+		for idx, p := range parts {
+			parts[idx] = "<@" + p + ">"
+		}
+		return strings.Join(parts, ", ")
 	case config.KindBool:
 		b, _ := strconv.ParseBool(raw)
 		return onOff(b)
@@ -448,6 +520,30 @@ func buildSelect(st config.Setting, raw string) discordgo.MessageComponent {
 			sm.DefaultValues = []discordgo.SelectMenuDefaultValue{{ID: raw, Type: discordgo.SelectMenuDefaultValueRole}}
 		}
 		return sm
+	case config.KindRoleList:
+		sm := discordgo.SelectMenu{
+			MenuType:    discordgo.RoleSelectMenu,
+			CustomID:    pickID(st.Key),
+			Placeholder: "Select roles (deselect all to clear)",
+			MinValues:   new(0),
+			MaxValues:   25,
+		}
+		for _, id := range splitCSV(raw) {
+			sm.DefaultValues = append(sm.DefaultValues, discordgo.SelectMenuDefaultValue{ID: id, Type: discordgo.SelectMenuDefaultValueRole})
+		}
+		return sm
+	case config.KindUserList:
+		sm := discordgo.SelectMenu{
+			MenuType:    discordgo.UserSelectMenu,
+			CustomID:    pickID(st.Key),
+			Placeholder: "Select users (deselect all to clear)",
+			MinValues:   new(0),
+			MaxValues:   25,
+		}
+		for _, id := range splitCSV(raw) {
+			sm.DefaultValues = append(sm.DefaultValues, discordgo.SelectMenuDefaultValue{ID: id, Type: discordgo.SelectMenuDefaultValueUser})
+		}
+		return sm
 	case config.KindChannelList:
 		ids := splitCSV(raw)
 		sm := discordgo.SelectMenu{
@@ -456,7 +552,7 @@ func buildSelect(st config.Setting, raw string) discordgo.MessageComponent {
 			Placeholder:  "Select channels (deselect all to clear)",
 			MinValues:    new(0),
 			MaxValues:    25,
-			ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildNews},
+			ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildNews, discordgo.ChannelTypeGuildForum},
 		}
 		for _, id := range ids {
 			sm.DefaultValues = append(sm.DefaultValues, discordgo.SelectMenuDefaultValue{ID: id, Type: discordgo.SelectMenuDefaultValueChannel})
@@ -505,7 +601,7 @@ func channelTypesFor(k config.Kind) []discordgo.ChannelType {
 
 func isSelectKind(k config.Kind) bool {
 	switch k {
-	case config.KindChannel, config.KindCategory, config.KindRole, config.KindChannelList, config.KindEnum:
+	case config.KindChannel, config.KindCategory, config.KindRole, config.KindChannelList, config.KindRoleList, config.KindUserList, config.KindEnum:
 		return true
 	default:
 		return false
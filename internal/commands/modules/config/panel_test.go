@@ -273,6 +273,8 @@ func TestFormatValue(t *testing.T) {
 		{"category", config.Setting{Kind: config.KindCategory}, "222", "<#222>"},
 		{"role", config.Setting{Kind: config.KindRole}, "333", "<@&333>"},
 		{"channel list", config.Setting{Kind: config.KindChannelList}, "1,2", "<#1>, <#2>"},
+		{"role list", config.Setting{Kind: config.KindRoleList}, "1,2", "<@&1>, <@&2>"},
+		{"user list", config.Setting{Kind: config.KindUserList}, "1,2", "<@1>, <@2>"},
 		{"bool on", config.Setting{Kind: config.KindBool}, "true", "✅ On"},
 		{"bool off", config.Setting{Kind: config.KindBool}, "false", "❌ Off"},
 		{"enum label", config.Setting{Kind: config.KindEnum, EnumOptions: []config.Option{{Value: "a", Label: "Apple"}}}, "a", "Apple"},
@@ -288,6 +290,16 @@ func TestFormatValue(t *testing.T) {
 	}
 }
 
+func TestSettingChangeString(t *testing.T) {
+	st := config.Setting{Label: "Log channel", Kind: config.KindChannel}
+	if got := (settingChange{Setting: st, Before: "1", After: "2"}).String(); got != "Log channel: <#1> → <#2>" {
+		t.Errorf("String = %q", got)
+	}
+	if got := (settingChange{Setting: st, After: "2"}).String(); got != "Log channel: _not set_ → <#2>" {
+		t.Errorf("String = %q", got)
+	}
+}
+
 func TestSplitCSVAndTruncate(t *testing.T) {
 	got := splitCSV(" 1 , ,2,3 ")
 	want := []string{"1", "2", "3"}
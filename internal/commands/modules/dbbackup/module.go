@@ -0,0 +1,166 @@
+package dbbackup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/database"
+	"gamerpal/internal/utils"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxAttachmentBytes is Discord's upload limit for bots in DMs.
+const maxAttachmentBytes = 10 * 1024 * 1024
+
+// Module implements the CommandModule interface for the /db-backup command
+type Module struct {
+	config *config.Config
+	db     *database.DB
+}
+
+// New creates a new db-backup module
+func New(deps *types.Dependencies) *Module {
+	return &Module{config: deps.Config, db: deps.DB}
+}
+
+// Register adds the /db-backup command to the command map
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	var adminPerms int64 = discordgo.PermissionAdministrator
+
+	cmds["db-backup"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "db-backup",
+			Description:              "DM a compressed backup of the bot database (SuperAdmin only)",
+			DefaultMemberPermissions: &adminPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextBotDM, discordgo.InteractionContextPrivateChannel},
+		},
+		HandlerFunc: m.handleDBBackup,
+	}
+}
+
+// Service returns nil as this module has no services requiring initialization
+func (m *Module) Service() types.ModuleService {
+	return nil
+}
+
+// handleDBBackup snapshots the database, gzips it, and attaches it to the
+// response. Only usable in bot DM context by super admins.
+func (m *Module) handleDBBackup(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.User == nil || !utils.IsSuperAdmin(i.User.ID, m.config) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "❌ You do not have permission to use this command.", Flags: discordgo.MessageFlagsEphemeral},
+		})
+		return
+	}
+	if m.db == nil {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "❌ Database not available.", Flags: discordgo.MessageFlagsEphemeral},
+		})
+		return
+	}
+
+	// Snapshotting and compressing can take a moment on a large database.
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+
+	name := fmt.Sprintf("gamerpal-backup-%s.db.gz", time.Now().UTC().Format("20060102-150405"))
+	data, rawSize, err := m.snapshot()
+	if err != nil {
+		m.config.Logger.Errorf("Database backup failed: %v", err)
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(fmt.Sprintf("❌ Backup failed: %v", err))})
+		return
+	}
+
+	if len(data) > maxAttachmentBytes {
+		msg := fmt.Sprintf(
+			"❌ The compressed backup is %s, over Discord's %s upload limit.\nCopy `%s` directly from the host volume instead, ideally while the bot is stopped.",
+			formatBytes(len(data)), formatBytes(maxAttachmentBytes), m.config.GetDatabasePath(),
+		)
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(msg)})
+		m.logBackup(s, i.User, name, rawSize, len(data), "too large to upload")
+		return
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(fmt.Sprintf("✅ Database backup (%s, %s uncompressed)", formatBytes(len(data)), formatBytes(int(rawSize)))),
+		Files:   []*discordgo.File{{Name: name, ContentType: "application/gzip", Reader: bytes.NewReader(data)}},
+	})
+	if err != nil {
+		m.config.Logger.Errorf("Failed to upload database backup: %v", err)
+		m.logBackup(s, i.User, name, rawSize, len(data), fmt.Sprintf("upload failed: %v", err))
+		return
+	}
+	m.logBackup(s, i.User, name, rawSize, len(data), "sent")
+}
+
+// snapshot copies the database to a temp file and returns it gzipped, along
+// with the uncompressed size.
+func (m *Module) snapshot() ([]byte, int64, error) {
+	dir, err := os.MkdirTemp("", "gamerpal-backup-")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "backup.db")
+	if err := m.db.Backup(path); err != nil {
+		return nil, 0, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open backup: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	n, err := io.Copy(zw, f)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to compress backup: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, 0, fmt.Errorf("failed to compress backup: %w", err)
+	}
+	return buf.Bytes(), n, nil
+}
+
+// logBackup records a backup attempt in the log channel.
+func (m *Module) logBackup(s *discordgo.Session, user *discordgo.User, name string, rawSize int64, compressed int, outcome string) {
+	logMsg := heredoc.Docf(`
+		[DBBackup]
+		User: %s (%s)
+		File: %s
+		Size: %s (%s uncompressed)
+		Outcome: %s
+	`, user.String(), user.ID, name, formatBytes(compressed), formatBytes(int(rawSize)), outcome)
+	m.config.Logger.Info(logMsg)
+	if err := utils.LogToChannel(m.config, s, logMsg); err != nil {
+		m.config.Logger.Errorf("failed logging database backup: %v", err)
+	}
+}
+
+// formatBytes renders a byte count as KiB/MiB for messages.
+func formatBytes(n int) string {
+	switch {
+	case n >= 1024*1024:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1024*1024))
+	case n >= 1024:
+		return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
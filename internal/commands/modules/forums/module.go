@@ -0,0 +1,137 @@
+package forums
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxForumsShown is Discord's embed field limit; each forum gets one field.
+const maxForumsShown = 25
+
+// Module implements the CommandModule interface for /forums.
+type Module struct {
+	config     *config.Config
+	forumCache *forumcache.Service
+}
+
+// New creates a new forums module
+func New(deps *types.Dependencies) *Module {
+	return &Module{
+		config:     deps.Config,
+		forumCache: deps.ForumCache,
+	}
+}
+
+// Register adds the /forums command
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	var modPerms int64 = discordgo.PermissionBanMembers
+	cmds["forums"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "forums",
+			Description:              "List the forums the thread cache tracks, what they're configured as, and their sync status",
+			DefaultMemberPermissions: &modPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+		},
+		HandlerFunc: m.handleForums,
+	}
+}
+
+// Service returns nil; this module has no recurring service.
+func (m *Module) Service() types.ModuleService { return nil }
+
+func (m *Module) handleForums(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	roles := forumRoles(m.config.ForGuild(i.GuildID))
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{forumsEmbed(m.forumCache, roles)},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// forumRoles maps each configured forum ID to what it is configured as. A
+// forum can hold more than one role if two settings point at it.
+func forumRoles(gc *config.GuildConfig) map[string][]string {
+	roles := make(map[string][]string)
+	add := func(id, role string) {
+		if id != "" {
+			roles[id] = append(roles[id], role)
+		}
+	}
+	for idx, id := range gc.GetLFGForumChannelIDs() {
+		if idx == 0 {
+			add(id, "LFG")
+		} else {
+			add(id, "LFG (extra)")
+		}
+	}
+	add(gc.GetGamerPalsIntroductionsForumChannelID(), "Introductions")
+	return roles
+}
+
+// forumsEmbed renders one field per registered forum with its configured
+// role and cache stats. Configured forums the cache hasn't registered are
+// listed in the description so a missing registration stands out.
+func forumsEmbed(fc *forumcache.Service, roles map[string][]string) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{Title: "Cached Forums", Color: utils.Colors.Info()}
+	if fc == nil {
+		embed.Description = "Forum cache unavailable."
+		return embed
+	}
+
+	registered := fc.RegisteredForums()
+	tracked := make(map[string]bool, len(registered))
+	for idx, id := range registered {
+		tracked[id] = true
+		if idx == maxForumsShown {
+			embed.Footer = &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("…and %d more forums not shown", len(registered)-maxForumsShown)}
+			continue
+		}
+		if idx > maxForumsShown {
+			continue
+		}
+		stats, _ := fc.Stats(id)
+		role := "Not configured"
+		if r := roles[id]; len(r) > 0 {
+			role = strings.Join(r, ", ")
+		}
+		sync := "never"
+		if !stats.LastFullSync.IsZero() {
+			sync = fmt.Sprintf("<t:%d:R>", stats.LastFullSync.Unix())
+			if stats.LastSyncTruncated {
+				sync += " (truncated at page cap)"
+			}
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: role,
+			Value: fmt.Sprintf("<#%s> (`%s`)\nThreads: %d • Owners: %d\nLast sync: %s • Anomalies: %d",
+				id, id, stats.Threads, stats.OwnersTracked, sync, stats.Anomalies),
+		})
+	}
+
+	var missing []string
+	for id, r := range roles {
+		if !tracked[id] {
+			missing = append(missing, fmt.Sprintf("<#%s> (%s)", id, strings.Join(r, ", ")))
+		}
+	}
+	switch {
+	case len(missing) > 0:
+		// Map order is random; keep the list stable between runs.
+		slices.Sort(missing)
+		embed.Description = "⚠️ Configured but not cached: " + strings.Join(missing, ", ")
+		embed.Color = utils.Colors.Warning()
+	case len(registered) == 0:
+		embed.Description = "No forums are registered with the cache."
+	}
+	return embed
+}
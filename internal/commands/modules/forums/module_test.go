@@ -0,0 +1,48 @@
+package forums
+
+import (
+	"testing"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForumRoles(t *testing.T) {
+	cfg, _ := forumcache.NewTestForumCache(map[string]any{
+		"gamerpals_lfg_forum_channel_id":           "lfg",
+		"lfg_extra_forum_channel_ids":              "console,shared",
+		"gamerpals_introductions_forum_channel_id": "shared",
+	})
+	roles := forumRoles(cfg.PrimaryGuild())
+	assert.Equal(t, map[string][]string{
+		"lfg":     {"LFG"},
+		"console": {"LFG (extra)"},
+		"shared":  {"LFG (extra)", "Introductions"},
+	}, roles)
+}
+
+func TestForumsEmbed(t *testing.T) {
+	_, fc := forumcache.NewTestForumCache(nil)
+	fc.RegisterForum("lfg")
+	fc.RegisterForum("other")
+	fc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: &discordgo.Channel{
+		ID: "t1", ParentID: "lfg", OwnerID: "u1", Name: "Halo", Type: discordgo.ChannelTypeGuildPublicThread,
+	}})
+
+	embed := forumsEmbed(fc, map[string][]string{"lfg": {"LFG"}, "intro": {"Introductions"}})
+	require.Len(t, embed.Fields, 2)
+	assert.Equal(t, "LFG", embed.Fields[0].Name)
+	assert.Contains(t, embed.Fields[0].Value, "<#lfg>")
+	assert.Contains(t, embed.Fields[0].Value, "Threads: 1 • Owners: 1")
+	assert.Contains(t, embed.Fields[0].Value, "Last sync: never")
+	assert.Equal(t, "Not configured", embed.Fields[1].Name)
+	assert.Contains(t, embed.Description, "<#intro> (Introductions)")
+
+	_, bare := forumcache.NewTestForumCache(nil)
+	empty := forumsEmbed(bare, nil)
+	assert.Empty(t, empty.Fields)
+	assert.Equal(t, "No forums are registered with the cache.", empty.Description)
+}
@@ -37,6 +37,11 @@ func helpCommandsEmbed() *discordgo.MessageEmbed {
 				Value:  "Show this help message",
 				Inline: false,
 			},
+			{
+				Name:   "/timezone",
+				Value:  "Set the timezone used when you type times like 3pm\n• Use `/timezone set zone:Europe/Berlin`",
+				Inline: false,
+			},
 			{
 				Name:   "🛠️ Moderator Commands:",
 				Inline: false,
@@ -46,6 +51,16 @@ func helpCommandsEmbed() *discordgo.MessageEmbed {
 				Value:  "Show member statistics for the server\n• Use `stats:overview` or `stats:daily` for different views",
 				Inline: false,
 			},
+			{
+				Name:   "/whois",
+				Value:  "Summarize a member's account age, join date, roles, introduction and latest LFG thread\n• Use `/whois user:@username`",
+				Inline: false,
+			},
+			{
+				Name:   "/forums",
+				Value:  "List the forums the thread cache tracks, what each is configured as, and thread counts and last sync",
+				Inline: false,
+			},
 			{
 				Name:   "/say",
 				Value:  "Send an anonymous message to a specified channel\n• Use `/say channel:#general message:Hello everyone!` to send a message",
@@ -53,12 +68,17 @@ func helpCommandsEmbed() *discordgo.MessageEmbed {
 			},
 			{
 				Name:   "/schedulesay",
-				Value:  "Schedule an anonymous message to be sent later\n• Use `/schedulesay channel:#general message:Text timestamp:123456789` to schedule",
+				Value:  "Schedule an anonymous message to be sent later\n• Use `/schedulesay channel:#general message:Text timestamp:123456789` to schedule\n• Or `time:3pm` to use your `/timezone`",
 				Inline: false,
 			},
 			{
 				Name:   "/listscheduledsays",
-				Value:  "List the next 20 scheduled messages",
+				Value:  "List queued scheduled messages, 10 per page",
+				Inline: false,
+			},
+			{
+				Name:   "/editscheduledsay",
+				Value:  "Change a scheduled message's text, channel or time, keeping its ID\n• Use `/editscheduledsay id:123 timestamp:123456789` to reschedule",
 				Inline: false,
 			},
 			{
@@ -68,7 +88,7 @@ func helpCommandsEmbed() *discordgo.MessageEmbed {
 			},
 			{
 				Name:   "/lfg-admin",
-				Value:  "LFG admin commands\n• `/lfg-admin setup-find-a-thread` - Set up find-a-thread panel\n• `/lfg-admin setup-looking-now` - Set up Looking NOW feed channel\n• `/lfg-admin refresh-thread-cache` - Rebuild thread cache",
+				Value:  "LFG admin commands\n• `/lfg-admin setup-find-a-thread` - Set up find-a-thread panel\n• `/lfg-admin setup-looking-now` - Set up Looking NOW feed channel\n• `/lfg-admin refresh-thread-cache` - Rebuild thread cache\n• `/lfg-admin clear-thread-cache` - Wipe and rebuild one forum's cache\n• `/lfg-admin reconcile` - Diff caches against Discord and list drift\n• `/lfg-admin trending` - Most active or newest LFG threads\n• `/lfg-admin migrate` - Rename legacy threads to IGDB titles",
 				Inline: false,
 			},
 			{
@@ -77,12 +97,17 @@ func helpCommandsEmbed() *discordgo.MessageEmbed {
 			},
 			{
 				Name:   "/prune-inactive",
-				Value:  "Remove users without any roles (dry run by default)\n• Use `execute:true` to actually remove users",
+				Value:  "Remove users without any roles (dry run by default)\n• Use `execute:true` to actually remove users\n• Aborts above `max_kicks` (default 50) unless `override:true`",
 				Inline: false,
 			},
 			{
 				Name:   "/prune-forum",
-				Value:  "Find forum threads whose starter post was deleted\n• Use `forum:#channel execute:true` to delete threads",
+				Value:  "Find forum threads from departed members and older duplicates\n• Use `forum:#channel execute:true` to delete threads",
+				Inline: false,
+			},
+			{
+				Name:   "/prune-allowlist",
+				Value:  "Threads /prune-forum never flags\n• `add`, `remove` or `list` by thread ID or link",
 				Inline: false,
 			},
 		},
@@ -36,5 +36,34 @@ func (m *Module) ConfigSettings() []config.Setting {
 			Kind:        config.KindInt,
 			Default:     0,
 		},
+		{
+			Key:         config.KeyIntroLegacyChannelID,
+			Category:    config.CategoryIntro,
+			Label:       "Legacy intro channel",
+			Description: "Text channel used for intros before the forum existed. /intro searches it when no forum post is found.",
+			Kind:        config.KindChannel,
+		},
+		{
+			Key:         config.KeyIntroGreeterChannelID,
+			Category:    config.CategoryIntro,
+			Label:       "Greeter channel",
+			Description: "Channel where new introductions are announced for greeters. Empty disables.",
+			Kind:        config.KindChannel,
+		},
+		{
+			Key:         config.KeyIntroGreeterRoleID,
+			Category:    config.CategoryIntro,
+			Label:       "Greeter role",
+			Description: "Role pinged when a new introduction is posted.",
+			Kind:        config.KindRole,
+		},
+		{
+			Key:         config.KeyIntroGreeterDebounce,
+			Category:    config.CategoryIntro,
+			Label:       "Greeter debounce",
+			Description: "Ignore repeat intros from the same member within this window (e.g. 10m).",
+			Kind:        config.KindDuration,
+			Default:     "10m",
+		},
 	}
 }
@@ -0,0 +1,75 @@
+package intro
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// NotifyGreeters announces a new introduction thread in the configured greeter
+// channel, pinging the greeter role so someone welcomes the newcomer. Failures
+// are logged and never interrupt the thread-create event handler.
+func (s *IntroFeedService) NotifyGreeters(thread *discordgo.Channel) {
+	if s.deps.Session == nil || thread == nil {
+		return
+	}
+
+	introForumID := s.deps.Config.GetGamerPalsIntroductionsForumChannelID()
+	if introForumID == "" || thread.ParentID != introForumID {
+		return
+	}
+
+	gcfg := s.deps.Config.ForGuild(thread.GuildID)
+	channelID := gcfg.GetIntroGreeterChannelID()
+	if channelID == "" {
+		return
+	}
+	window := gcfg.GetIntroGreeterDebounce()
+	if !s.canGreet(thread.OwnerID, time.Now(), window) {
+		s.deps.Config.Logger.Infof("Skipping greeter ping for %s: intro posted within debounce window", thread.OwnerID)
+		return
+	}
+
+	_, err := s.deps.Session.ChannelMessageSendComplex(channelID, greeterMessage(thread, gcfg.GetIntroGreeterRoleID()))
+	if err != nil {
+		s.deps.Config.Logger.Warnf("Failed to send greeter notification for intro %s: %v", thread.ID, err)
+		return
+	}
+	s.recordGreet(thread.OwnerID, time.Now(), window)
+}
+
+// canGreet reports whether ownerID may trigger a greeter notification now.
+func (s *IntroFeedService) canGreet(ownerID string, now time.Time, window time.Duration) bool {
+	s.greetMu.Lock()
+	defer s.greetMu.Unlock()
+	last, ok := s.lastGreet[ownerID]
+	return !ok || now.Sub(last) >= window
+}
+
+// recordGreet starts ownerID's debounce window. Call it only after the
+// notification was sent, so a failed send doesn't suppress the next intro.
+func (s *IntroFeedService) recordGreet(ownerID string, now time.Time, window time.Duration) {
+	s.greetMu.Lock()
+	defer s.greetMu.Unlock()
+	s.lastGreet[ownerID] = now
+	// Keep the map from growing without bound.
+	for id, t := range s.lastGreet {
+		if now.Sub(t) >= window {
+			delete(s.lastGreet, id)
+		}
+	}
+}
+
+// greeterMessage builds the announcement. Only the greeter role may be pinged;
+// the newcomer is mentioned for context but not notified.
+func greeterMessage(thread *discordgo.Channel, roleID string) *discordgo.MessageSend {
+	postURL := fmt.Sprintf("https://discord.com/channels/%s/%s", thread.GuildID, thread.ID)
+	content := fmt.Sprintf("👋 <@%s> just posted an introduction! Go say hi: %s", thread.OwnerID, postURL)
+	allowed := &discordgo.MessageAllowedMentions{}
+	if roleID != "" {
+		content = fmt.Sprintf("<@&%s> %s", roleID, content)
+		allowed.Roles = []string{roleID}
+	}
+	return &discordgo.MessageSend{Content: content, AllowedMentions: allowed}
+}
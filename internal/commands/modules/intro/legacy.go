@@ -0,0 +1,77 @@
+package intro
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// legacyScanPageSize is the Discord maximum for a single message fetch.
+	legacyScanPageSize = 100
+	// legacyScanMaxPages bounds how far back the legacy channel is scanned.
+	legacyScanMaxPages = 20
+	// introSnippetChars caps the intro excerpt shown in embeds.
+	introSnippetChars = 300
+)
+
+// introChannelMessages fetches one page of channel history, newest first.
+// Overridable in tests.
+var introChannelMessages = func(s *discordgo.Session, channelID, beforeID string) ([]*discordgo.Message, error) {
+	return s.ChannelMessages(channelID, legacyScanPageSize, beforeID, "", "")
+}
+
+// findLegacyIntro returns the user's most recent message in the legacy intro
+// channel, scanning at most legacyScanMaxPages pages. A nil message with a nil
+// error means the user has no message within the scanned history.
+func findLegacyIntro(s *discordgo.Session, channelID, userID string) (*discordgo.Message, error) {
+	beforeID := ""
+	for page := 0; page < legacyScanMaxPages; page++ {
+		msgs, err := introChannelMessages(s, channelID, beforeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch legacy intro messages: %w", err)
+		}
+		for _, msg := range msgs {
+			if msg.Author != nil && msg.Author.ID == userID && strings.TrimSpace(msg.Content) != "" {
+				return msg, nil
+			}
+		}
+		if len(msgs) < legacyScanPageSize {
+			return nil, nil
+		}
+		beforeID = msgs[len(msgs)-1].ID
+	}
+	return nil, nil
+}
+
+// legacyIntroEdit renders a legacy-channel hit, labelling its source so it
+// isn't mistaken for a forum intro.
+func legacyIntroEdit(guildID string, msg *discordgo.Message) *discordgo.WebhookEdit {
+	msgURL := fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, msg.ChannelID, msg.ID)
+	return &discordgo.WebhookEdit{
+		Content: new(msgURL),
+		Embeds: &[]*discordgo.MessageEmbed{{
+			Title:       "Legacy introduction",
+			URL:         msgURL,
+			Description: introSnippet(msg.Content),
+			Color:       utils.Colors.Info(),
+			Fields: []*discordgo.MessageEmbedField{
+				{Name: "Source", Value: fmt.Sprintf("Legacy intro channel <#%s> (no forum post found)", msg.ChannelID)},
+			},
+			Timestamp: msg.Timestamp.Format(time.RFC3339),
+		}},
+	}
+}
+
+// introSnippet trims content to introSnippetChars runes for embed previews.
+func introSnippet(content string) string {
+	runes := []rune(strings.TrimSpace(content))
+	if len(runes) > introSnippetChars {
+		return string(runes[:introSnippetChars]) + "…"
+	}
+	return string(runes)
+}
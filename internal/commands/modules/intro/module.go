@@ -63,6 +63,12 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 					Description: "Whether the reply should be ephemeral (default: true)",
 					Required:    false,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "legacy",
+					Description: "Search the legacy intro channel if no forum post exists (default: true)",
+					Required:    false,
+				},
 			},
 		},
 		HandlerFunc: m.handleIntroSlash,
@@ -78,6 +84,26 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 		HandlerFunc: m.handleBumpIntro,
 	}
 
+	// Random intro command - surface a random member's introduction
+	minAgeDays := float64(1)
+	cmds["intro-random"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:        "intro-random",
+			Description: "Show a random member introduction so you can say hi",
+			Contexts:    &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "max_age_days",
+					Description: "Only pick introductions posted within this many days",
+					Required:    false,
+					MinValue:    &minAgeDays,
+				},
+			},
+		},
+		HandlerFunc: m.handleIntroRandom,
+	}
+
 	// User context (right-click / tap user) command version – enables quick lookup without typing.
 	// For user & message context commands Discord allows spaces and capitalization.
 	cmds["Lookup intro"] = &types.Command{
@@ -105,8 +131,9 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 }
 
 // introLookup performs the introduction post lookup for the specified target user,
-// and responds to the interaction accordingly.
-func (m *Module) introLookup(s *discordgo.Session, i *discordgo.InteractionCreate, targetUser *discordgo.User, ephemeral bool) {
+// and responds to the interaction accordingly. When legacyFallback is set and the
+// forum has no post, the configured legacy intro channel is searched instead.
+func (m *Module) introLookup(s *discordgo.Session, i *discordgo.InteractionCreate, targetUser *discordgo.User, ephemeral, legacyFallback bool) {
 	introsChannelID := m.config.Config.GetGamerPalsIntroductionsForumChannelID()
 
 	// Resolve actor (the user performing the lookup) for logging purposes.
@@ -229,6 +256,7 @@ func (m *Module) introLookup(s *discordgo.Session, i *discordgo.InteractionCreat
 					  Threads: %d
 					  OwnersTracked: %d
 					  LastFullSync: %s
+					  LastSyncTruncated: %t
 					  EventAdds: %d
 					  EventUpdates: %d
 					  EventDeletes: %d
@@ -255,6 +283,7 @@ func (m *Module) introLookup(s *discordgo.Session, i *discordgo.InteractionCreat
 					stats.Threads,
 					stats.OwnersTracked,
 					stats.LastFullSync.Format(time.RFC3339),
+					stats.LastSyncTruncated,
 					stats.EventAdds,
 					stats.EventUpdates,
 					stats.EventDeletes,
@@ -296,6 +325,31 @@ func (m *Module) introLookup(s *discordgo.Session, i *discordgo.InteractionCreat
 		}
 	}
 
+	if legacyFallback {
+		if legacyChannelID := m.config.Config.ForGuild(i.GuildID).GetIntroLegacyChannelID(); legacyChannelID != "" {
+			msg, err := findLegacyIntro(s, legacyChannelID, targetUser.ID)
+			if err != nil {
+				m.config.Config.Logger.Warnf("legacy intro lookup failed for %s: %v", targetUser.ID, err)
+			} else if msg != nil {
+				legacyMsg := heredoc.Doc(fmt.Sprintf(`
+					[IntroLookupLegacyHit]
+					Target: %s (%s)
+					Guild: %s
+					Channel: %s
+					MessageID: %s
+				`, targetUser.String(), targetUser.ID, i.GuildID, legacyChannelID, msg.ID))
+				if err := introLog(m.config, s, legacyMsg); err != nil {
+					m.config.Config.Logger.Warnf("failed to log legacy intro hit: %v", err)
+				}
+				if msg.ChannelID == "" {
+					msg.ChannelID = legacyChannelID
+				}
+				_, _ = introEdit(s, i.Interaction, legacyIntroEdit(i.GuildID, msg))
+				return
+			}
+		}
+	}
+
 	_, _ = introEdit(s, i.Interaction, &discordgo.WebhookEdit{
 		Content: new(fmt.Sprintf("❌ No introduction post found for %s.", targetUser.Mention())),
 	})
@@ -306,6 +360,7 @@ func (m *Module) handleIntroSlash(s *discordgo.Session, i *discordgo.Interaction
 	var targetUser *discordgo.User
 	options := i.ApplicationCommandData().Options
 	ephemeral := true // default
+	legacyFallback := true
 	for _, opt := range options {
 		if opt.Name == "user" {
 			targetUser = opt.UserValue(s)
@@ -313,6 +368,9 @@ func (m *Module) handleIntroSlash(s *discordgo.Session, i *discordgo.Interaction
 		if opt.Name == "ephemeral" {
 			ephemeral = opt.BoolValue()
 		}
+		if opt.Name == "legacy" {
+			legacyFallback = opt.BoolValue()
+		}
 	}
 	if targetUser == nil && i.Member != nil {
 		targetUser = i.Member.User
@@ -328,7 +386,7 @@ func (m *Module) handleIntroSlash(s *discordgo.Session, i *discordgo.Interaction
 		})
 		return
 	}
-	m.introLookup(s, i, targetUser, ephemeral)
+	m.introLookup(s, i, targetUser, ephemeral, legacyFallback)
 }
 
 // User context command handler – target user resolved from interaction TargetID.
@@ -350,7 +408,7 @@ func (m *Module) handleIntroUserContext(s *discordgo.Session, i *discordgo.Inter
 		return
 	}
 	// User context command is always ephemeral per requirements.
-	m.introLookup(s, i, targetUser, true)
+	m.introLookup(s, i, targetUser, true, true)
 }
 
 // handleBumpIntro handles the /bump-intro command to manually post an intro to the feed
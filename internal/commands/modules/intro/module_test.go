@@ -223,3 +223,63 @@ func TestUserIntroConfigMissingAlwaysEphemeral(t *testing.T) {
 	require.Len(t, cap.lastRespondEphemeral, 1)
 	assert.True(t, cap.lastRespondEphemeral[0])
 }
+
+func TestIntroLegacyFallback(t *testing.T) {
+	cfg, fc := forumcache.NewTestForumCache(map[string]any{
+		"gamerpals_introductions_forum_channel_id": "forumC",
+		"intro_legacy_channel_id":                  "legacyChan",
+	})
+	deps := &types.Dependencies{Config: cfg, ForumCache: fc}
+	mod := New(deps)
+	mod.Register(map[string]*types.Command{}, deps)
+
+	origMessages := introChannelMessages
+	defer func() { introChannelMessages = origMessages }()
+	fetches := 0
+	introChannelMessages = func(_ *discordgo.Session, channelID, _ string) ([]*discordgo.Message, error) {
+		fetches++
+		require.Equal(t, "legacyChan", channelID)
+		return []*discordgo.Message{
+			{ID: "m3", ChannelID: channelID, Author: &discordgo.User{ID: "someoneElse"}, Content: "hey"},
+			{ID: "m2", ChannelID: channelID, Author: &discordgo.User{ID: "oldTimer"}, Content: "Hi! I play a lot of Factorio."},
+			{ID: "m1", ChannelID: channelID, Author: &discordgo.User{ID: "oldTimer"}, Content: "older message"},
+		}, nil
+	}
+
+	t.Run("finds most recent legacy message", func(t *testing.T) {
+		cap := &hookCapture{}
+		var lastEdit *discordgo.WebhookEdit
+		withHooks(t, cap, func() {
+			inner := introEdit
+			introEdit = func(s *discordgo.Session, inter *discordgo.Interaction, edit *discordgo.WebhookEdit) (*discordgo.Message, error) {
+				lastEdit = edit
+				return inner(s, inter, edit)
+			}
+			mod.handleIntroSlash(&discordgo.Session{}, buildInteraction("guild4", "oldTimer"))
+		})
+		require.Equal(t, 1, cap.edits)
+		assert.Equal(t, "https://discord.com/channels/guild4/legacyChan/m2", cap.lastEdit)
+		require.NotNil(t, lastEdit.Embeds)
+		embed := (*lastEdit.Embeds)[0]
+		assert.Contains(t, embed.Description, "Factorio")
+		assert.Contains(t, embed.Fields[0].Value, "Legacy intro channel")
+		assert.Contains(t, cap.logs[len(cap.logs)-1], "[IntroLookupLegacyHit]")
+	})
+
+	t.Run("skipped when legacy option is false", func(t *testing.T) {
+		fetches = 0
+		cap := &hookCapture{}
+		withHooks(t, cap, func() {
+			inter := buildInteraction("guild4", "oldTimer")
+			inter.Data = discordgo.ApplicationCommandInteractionData{
+				Name: "intro",
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{Name: "legacy", Type: discordgo.ApplicationCommandOptionBoolean, Value: false},
+				},
+			}
+			mod.handleIntroSlash(&discordgo.Session{}, inter)
+		})
+		assert.Zero(t, fetches)
+		assert.Contains(t, cap.lastEdit, "No introduction post found")
+	})
+}
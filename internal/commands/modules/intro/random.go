@@ -0,0 +1,118 @@
+package intro
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// randomIntroMaxChecks bounds how many candidates are checked for guild
+// membership before giving up, so a forum full of departed members can't turn
+// one command into hundreds of API calls.
+const randomIntroMaxChecks = 15
+
+// introMemberPresent reports whether the user is still in the guild.
+// Overridable in tests.
+var introMemberPresent = func(s *discordgo.Session, guildID, userID string) bool {
+	if s.State != nil {
+		if m, err := s.State.Member(guildID, userID); err == nil && m != nil {
+			return true
+		}
+	}
+	_, err := s.GuildMember(guildID, userID)
+	return err == nil
+}
+
+// introStarterMessage fetches a forum thread's starter post. Overridable in tests.
+var introStarterMessage = func(s *discordgo.Session, threadID string) (*discordgo.Message, error) {
+	return s.ChannelMessage(threadID, threadID)
+}
+
+// pickRandomIntro returns a random thread created after minCreated (zero means
+// no age limit) whose owner passes isMember. At most randomIntroMaxChecks
+// candidates are checked.
+func pickRandomIntro(threads []*forumcache.ThreadMeta, minCreated time.Time, isMember func(ownerID string) bool) *forumcache.ThreadMeta {
+	candidates := make([]*forumcache.ThreadMeta, 0, len(threads))
+	for _, t := range threads {
+		if t.OwnerID == "" || (!minCreated.IsZero() && t.CreatedAt.Before(minCreated)) {
+			continue
+		}
+		candidates = append(candidates, t)
+	}
+	rand.Shuffle(len(candidates), func(a, b int) { candidates[a], candidates[b] = candidates[b], candidates[a] })
+
+	for idx, t := range candidates {
+		if idx >= randomIntroMaxChecks {
+			break
+		}
+		if isMember(t.OwnerID) {
+			return t
+		}
+	}
+	return nil
+}
+
+// handleIntroRandom handles /intro-random, posting a random member's intro so
+// others can discover and welcome them.
+func (m *Module) handleIntroRandom(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	introsChannelID := m.config.Config.GetGamerPalsIntroductionsForumChannelID()
+	if introsChannelID == "" || m.config.ForumCache == nil {
+		respondEphemeral(s, i, "❌ Introductions forum is not configured.")
+		return
+	}
+
+	var minCreated time.Time
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "max_age_days" && opt.IntValue() > 0 {
+			minCreated = time.Now().AddDate(0, 0, -int(opt.IntValue()))
+		}
+	}
+
+	threads, ok := m.config.ForumCache.ListThreads(introsChannelID)
+	if !ok || len(threads) == 0 {
+		respondEphemeral(s, i, "❌ No introductions are cached yet. Try again in a bit.")
+		return
+	}
+
+	_ = introRespond(s, i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	meta := pickRandomIntro(threads, minCreated, func(ownerID string) bool {
+		return introMemberPresent(s, i.GuildID, ownerID)
+	})
+	if meta == nil {
+		_, _ = introEdit(s, i.Interaction, &discordgo.WebhookEdit{
+			Content: new("❌ Couldn't find an introduction from a current member. Try a wider age range."),
+		})
+		return
+	}
+
+	postURL := fmt.Sprintf("https://discord.com/channels/%s/%s", i.GuildID, meta.ID)
+	embed := &discordgo.MessageEmbed{
+		Title: meta.Name,
+		URL:   postURL,
+		Color: utils.Colors.Fancy(),
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Member", Value: fmt.Sprintf("<@%s>", meta.OwnerID), Inline: true},
+			{Name: "Posted", Value: fmt.Sprintf("<t:%d:R>", meta.CreatedAt.Unix()), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Go say hi! 👋"},
+	}
+	if msg, err := introStarterMessage(s, meta.ID); err != nil {
+		m.config.Config.Logger.Warnf("intro-random: failed to fetch starter message for %s: %v", meta.ID, err)
+	} else {
+		embed.Description = introSnippet(msg.Content)
+	}
+
+	_, _ = introEdit(s, i.Interaction, &discordgo.WebhookEdit{
+		Content:         new(postURL),
+		Embeds:          &[]*discordgo.MessageEmbed{embed},
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+}
@@ -0,0 +1,44 @@
+package intro
+
+import (
+	"testing"
+	"time"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickRandomIntro(t *testing.T) {
+	now := time.Now()
+	threads := []*forumcache.ThreadMeta{
+		{ID: "1", OwnerID: "left", CreatedAt: now.Add(-time.Hour)},
+		{ID: "2", OwnerID: "stayed", CreatedAt: now.Add(-time.Hour)},
+		{ID: "3", OwnerID: "old", CreatedAt: now.AddDate(0, 0, -30)},
+	}
+	members := map[string]bool{"stayed": true, "old": true}
+	isMember := func(id string) bool { return members[id] }
+
+	t.Run("skips members who left", func(t *testing.T) {
+		for range 20 {
+			got := pickRandomIntro(threads, now.AddDate(0, 0, -7), isMember)
+			require.NotNil(t, got)
+			assert.Equal(t, "2", got.ID)
+		}
+	})
+
+	t.Run("zero minCreated includes old intros", func(t *testing.T) {
+		seen := map[string]bool{}
+		for range 100 {
+			got := pickRandomIntro(threads, time.Time{}, isMember)
+			require.NotNil(t, got)
+			seen[got.ID] = true
+		}
+		assert.Equal(t, map[string]bool{"2": true, "3": true}, seen)
+	})
+
+	t.Run("nil when nobody qualifies", func(t *testing.T) {
+		assert.Nil(t, pickRandomIntro(threads, now, isMember))
+	})
+}
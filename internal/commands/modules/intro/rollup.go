@@ -76,7 +76,7 @@ func (m *Module) handleIntroductionRollup(s *discordgo.Session, i *discordgo.Int
 	}
 
 	// Split and send the rollup as visible messages in the channel
-	chunks := splitMessage(rollupText, 2000)
+	chunks := utils.SplitMessage(rollupText, 2000)
 	for _, chunk := range chunks {
 		_, err = s.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
 			Content:         chunk,
@@ -206,38 +206,3 @@ func (svc *IntroFeedService) GenerateRollup(s *discordgo.Session, guildID string
 
 	return result, mentionedUserIDs, nil
 }
-
-// splitMessage splits text into chunks of at most maxLen runes,
-// preferring to break at newline boundaries.
-func splitMessage(text string, maxLen int) []string {
-	runes := []rune(text)
-	if len(runes) <= maxLen {
-		return []string{text}
-	}
-
-	var chunks []string
-	for len(runes) > 0 {
-		if len(runes) <= maxLen {
-			chunks = append(chunks, string(runes))
-			break
-		}
-
-		// Look for the last newline within the rune limit
-		limit := min(maxLen, len(runes))
-		cut := -1
-		for i := limit - 1; i >= 0; i-- {
-			if runes[i] == '\n' {
-				cut = i + 1 // include the newline in the current chunk
-				break
-			}
-		}
-		if cut <= 0 {
-			// No newline found — hard cut at maxLen runes
-			cut = limit
-		}
-
-		chunks = append(chunks, string(runes[:cut]))
-		runes = runes[cut:]
-	}
-	return chunks
-}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"gamerpal/internal/commands/types"
@@ -18,12 +19,16 @@ import (
 type IntroFeedService struct {
 	types.BaseService
 	deps *types.Dependencies
+
+	greetMu   sync.Mutex
+	lastGreet map[string]time.Time // ownerID → last greeter notification
 }
 
 // NewIntroFeedService creates a new intro feed service
 func NewIntroFeedService(deps *types.Dependencies) *IntroFeedService {
 	return &IntroFeedService{
-		deps: deps,
+		deps:      deps,
+		lastGreet: make(map[string]time.Time),
 	}
 }
 
@@ -65,3 +65,30 @@ func TestCooldownHoursForMember(t *testing.T) {
 		assert.Equal(t, 48, svc.cooldownHoursForMember(&discordgo.Member{}))
 	})
 }
+
+func TestGreetDebounce(t *testing.T) {
+	svc := NewIntroFeedService(&types.Dependencies{Config: config.NewMockConfig(map[string]any{})})
+	now := time.Now()
+	window := 10 * time.Minute
+
+	assert.True(t, svc.canGreet("u1", now, window))
+	assert.True(t, svc.canGreet("u1", now, window), "checking alone doesn't debounce, so a failed send can retry")
+	svc.recordGreet("u1", now, window)
+	assert.False(t, svc.canGreet("u1", now.Add(5*time.Minute), window), "repeat within window is debounced")
+	assert.True(t, svc.canGreet("u2", now.Add(5*time.Minute), window), "other members are independent")
+	assert.True(t, svc.canGreet("u1", now.Add(11*time.Minute), window), "allowed again after window")
+}
+
+func TestGreeterMessage(t *testing.T) {
+	thread := &discordgo.Channel{ID: "t1", GuildID: "g1", OwnerID: "u1"}
+
+	msg := greeterMessage(thread, "r1")
+	assert.Contains(t, msg.Content, "<@&r1>")
+	assert.Contains(t, msg.Content, "https://discord.com/channels/g1/t1")
+	assert.Equal(t, []string{"r1"}, msg.AllowedMentions.Roles)
+	assert.Empty(t, msg.AllowedMentions.Users)
+
+	noRole := greeterMessage(thread, "")
+	assert.NotContains(t, noRole.Content, "<@&")
+	assert.Empty(t, noRole.AllowedMentions.Roles)
+}
@@ -1,8 +1,11 @@
 package lfg
 
 import (
+	"errors"
 	"fmt"
 
+	"gamerpal/internal/agentctx"
+
 	"github.com/bwmarrin/discordgo"
 	copilot "github.com/github/copilot-sdk/go"
 )
@@ -19,7 +22,7 @@ type gameSuggestion struct {
 }
 
 type findOrCreateResult struct {
-	// Status is one of: found_existing, created_new, needs_disambiguation, no_matches.
+	// Status is one of: found_existing, created_new, needs_disambiguation, no_matches, rate_limited.
 	Status      string           `json:"status"`
 	Thread      *threadInfo      `json:"thread,omitempty"`
 	Suggestions []gameSuggestion `json:"suggestions,omitempty"`
@@ -47,7 +50,7 @@ type lfgSearchParams struct {
 func (m *Module) newLFGSearchTool() copilot.Tool {
 	t := copilot.DefineTool(
 		"lfg_search",
-		"Search the GamerPals LFG forum for existing game threads by name. Returns up to N results ordered by relevance. Use this to check whether a thread already exists before creating one.",
+		"Search the GamerPals LFG forums for existing game threads by name. Returns up to N results ordered by relevance. Use this to check whether a thread already exists before creating one.",
 		func(p lfgSearchParams, _ copilot.ToolInvocation) (*searchResult, error) {
 			limit := p.Limit
 			if limit <= 0 || limit > 10 {
@@ -57,7 +60,7 @@ func (m *Module) newLFGSearchTool() copilot.Tool {
 			if forumID == "" {
 				return &searchResult{Note: "lfg forum not configured"}, nil
 			}
-			hits := m.searchForumThreads(forumID, p.Query, limit)
+			hits := m.searchForumThreads(m.config.GetLFGForumChannelIDs(), p.Query, limit)
 			if len(hits) == 0 {
 				return &searchResult{Note: "no matching threads"}, nil
 			}
@@ -79,8 +82,8 @@ type lfgFindOrCreateParams struct {
 func (m *Module) newLFGFindOrCreateTool() copilot.Tool {
 	t := copilot.DefineTool(
 		"lfg_find_or_create_thread",
-		`Find an existing LFG forum thread for a game, or create one with IGDB enrichment (cover art, summary, links). If the name is ambiguous, returns IGDB suggestions instead of creating; pick one and call again with that exact name. Status is one of: "found_existing", "created_new", "needs_disambiguation", "no_matches".`,
-		func(p lfgFindOrCreateParams, _ copilot.ToolInvocation) (*findOrCreateResult, error) {
+		`Find an existing LFG forum thread for a game, or create one with IGDB enrichment (cover art, summary, links). If the name is ambiguous, returns IGDB suggestions instead of creating; pick one and call again with that exact name. New threads count against the caller's thread creation limit; when it is reached nothing is created. Status is one of: "found_existing", "created_new", "needs_disambiguation", "no_matches", "rate_limited".`,
+		func(p lfgFindOrCreateParams, inv copilot.ToolInvocation) (*findOrCreateResult, error) {
 			if p.GameName == "" {
 				return &findOrCreateResult{Status: "no_matches", Note: "empty game name"}, nil
 			}
@@ -91,7 +94,14 @@ func (m *Module) newLFGFindOrCreateTool() copilot.Tool {
 			if forumID == "" {
 				return nil, fmt.Errorf("lfg forum channel id not configured")
 			}
-			ch, created, suggestions, err := m.lookupOrCreateGameThread(forumID, p.GameName)
+			// The caller comes from host-side state, so creations are counted
+			// against the member who asked, as they are for the buttons.
+			caller, _ := agentctx.CallerForSession(inv.SessionID)
+			ch, created, suggestions, err := m.lookupOrCreateGameThread(m.config.GetLFGForumChannelIDs(), p.GameName, caller.UserID, caller.IsAdmin)
+			var limited *threadRateLimitedError
+			if errors.As(err, &limited) {
+				return &findOrCreateResult{Status: "rate_limited", Note: fmt.Sprintf("the caller created too many threads recently; they can create another <t:%d:R>", limited.RetryAt.Unix())}, nil
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -13,6 +13,13 @@ func (m *Module) ConfigSettings() []config.Setting {
 			Description: "Forum channel for looking-for-game posts.",
 			Kind:        config.KindChannel,
 		},
+		{
+			Key:         config.KeyLFGExtraForumIDs,
+			Category:    config.CategoryLFG,
+			Label:       "Extra LFG forums",
+			Description: "More forums searched alongside the LFG forum (e.g. console games). New threads are still created in the LFG forum.",
+			Kind:        config.KindChannelList,
+		},
 		{
 			Key:         config.KeyLFGNowPanelChannelID,
 			Category:    config.CategoryLFG,
@@ -35,5 +42,105 @@ func (m *Module) ConfigSettings() []config.Setting {
 			Kind:        config.KindDuration,
 			Default:     "1h",
 		},
+		{
+			Key:         config.KeyLFGPingRoleID,
+			Category:    config.CategoryLFG,
+			Label:       "LFG thread ping role",
+			Description: "Role mentioned when someone runs /lfg now in a game thread (unset: @here).",
+			Kind:        config.KindRole,
+		},
+		{
+			Key:         config.KeyLFGPingCooldown,
+			Category:    config.CategoryLFG,
+			Label:       "LFG thread ping cooldown",
+			Description: "How long before a user can ping the same game thread again (e.g. 30m).",
+			Kind:        config.KindDuration,
+			Default:     "30m",
+		},
+		{
+			Key:         config.KeyLFGNowPostExpiry,
+			Category:    config.CategoryLFG,
+			Label:       "Looking NOW post expiry",
+			Description: "How long a /lfg now post stays active before it's marked no longer looking (e.g. 45m).",
+			Kind:        config.KindDuration,
+			Default:     "45m",
+		},
+		{
+			Key:         config.KeyLFGVoiceCategoryID,
+			Category:    config.CategoryLFG,
+			Label:       "LFG temp voice category",
+			Description: "Category where /lfg now creates temporary voice channels. Unset disables create_voice.",
+			Kind:        config.KindCategory,
+		},
+		{
+			Key:         config.KeyLFGVoiceEmptyGrace,
+			Category:    config.CategoryLFG,
+			Label:       "LFG temp voice empty grace",
+			Description: "How long a temporary LFG voice channel can stay empty before it's deleted (e.g. 5m).",
+			Kind:        config.KindDuration,
+			Default:     "5m",
+		},
+		{
+			Key:         config.KeyLFGThreadCreateLimit,
+			Category:    config.CategoryLFG,
+			Label:       "Thread creation limit",
+			Description: "How many game threads a member can create per window. Moderators are exempt.",
+			Kind:        config.KindInt,
+			Default:     3,
+		},
+		{
+			Key:         config.KeyLFGThreadCreateWin,
+			Category:    config.CategoryLFG,
+			Label:       "Thread creation window",
+			Description: "Window for the thread creation limit (e.g. 10m).",
+			Kind:        config.KindDuration,
+			Default:     "10m",
+		},
+		{
+			Key:         config.KeyLFGGameThreadCooldown,
+			Category:    config.CategoryLFG,
+			Label:       "/game-thread cooldown",
+			Description: "How long before a member can run /game-thread again (e.g. 15s). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "15s",
+		},
+		{
+			Key:         config.KeyLFGRefreshCacheCooldown,
+			Category:    config.CategoryLFG,
+			Label:       "Thread cache refresh cooldown",
+			Description: "How long before /lfg-admin refresh-thread-cache can run again in this server (e.g. 5m). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "5m",
+		},
+		{
+			Key:         config.KeyLFGMaxSuggestions,
+			Category:    config.CategoryLFG,
+			Label:       "Max game suggestions",
+			Description: "How many IGDB titles members can page through (5 per page) when creating a thread. 5-50.",
+			Kind:        config.KindInt,
+			Default:     25,
+		},
+		{
+			Key:         config.KeyLFGThreadAutoArchive,
+			Category:    config.CategoryLFG,
+			Label:       "Thread auto-archive",
+			Description: "How long a new game thread can go quiet before Discord archives it.",
+			Kind:        config.KindEnum,
+			Default:     "4320",
+			EnumOptions: []config.Option{
+				{Value: "60", Label: "1 hour"},
+				{Value: "1440", Label: "1 day"},
+				{Value: "4320", Label: "3 days"},
+				{Value: "10080", Label: "1 week"},
+			},
+		},
+		{
+			Key:         config.KeyLFGPinStarterMessage,
+			Category:    config.CategoryLFG,
+			Label:       "Pin game info",
+			Description: "Pin the game summary and links the bot posts when it creates a thread.",
+			Kind:        config.KindBool,
+			Default:     false,
+		},
 	}
 }
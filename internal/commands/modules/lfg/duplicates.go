@@ -0,0 +1,72 @@
+package lfg
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxDuplicateWarnings bounds how many existing threads the collision
+// warning lists (each gets a link button, and a row holds five buttons
+// including "Create anyway").
+const maxDuplicateWarnings = 4
+
+// compactName folds a name down to lowercase letters and digits so that
+// "Rocket League", "rocket-league" and "RocketLeague" all compare equal.
+func compactName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isNearDuplicateName reports whether an existing thread name collides with
+// a game name once case, spacing and punctuation are ignored.
+func isNearDuplicateName(gameName, threadName string) bool {
+	g := compactName(gameName)
+	return g != "" && g == compactName(threadName)
+}
+
+// nearDuplicateThreads returns live threads in the given forums whose names
+// collide with gameName. Callers check for an exact match in the target forum
+// first; this catches what that lookup misses.
+func (m *Module) nearDuplicateThreads(forumIDs []string, gameName string) []*discordgo.Channel {
+	if m.forumCache == nil || compactName(gameName) == "" {
+		return nil
+	}
+
+	var out []*discordgo.Channel
+	seen := make(map[string]struct{})
+	for _, fid := range forumIDs {
+		m.forumCache.RegisterForum(fid)
+		threads, ok := m.forumCache.ListThreads(fid)
+		if !ok {
+			continue
+		}
+		// Newest first so the most active community is suggested first.
+		sort.Slice(threads, func(a, b int) bool { return threads[a].CreatedAt.After(threads[b].CreatedAt) })
+		for _, meta := range threads {
+			if !isNearDuplicateName(gameName, meta.Name) {
+				continue
+			}
+			if _, dup := seen[meta.ID]; dup {
+				continue
+			}
+			ch, ok := m.threadChannel(meta.ID, fid)
+			if !ok {
+				continue
+			}
+			seen[meta.ID] = struct{}{}
+			out = append(out, ch)
+			if len(out) >= maxDuplicateWarnings {
+				return out
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package lfg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNearDuplicateName(t *testing.T) {
+	cases := []struct {
+		game, thread string
+		want         bool
+	}{
+		{"Valorant", "VALORANT", true},
+		{"Rocket League", "rocket-league", true},
+		{"Counter-Strike 2", "Counter Strike 2", true},
+		{"No Man's Sky", "No Mans Sky", true},
+		{"Valorant", "Valorant 2", false},
+		{"Portal", "Portal 2", false},
+		{"!!!", "???", false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, isNearDuplicateName(c.game, c.thread), "%q vs %q", c.game, c.thread)
+	}
+}
+
+func TestDuplicateThreadsComponents(t *testing.T) {
+	dups := []*discordgo.Channel{
+		{ID: "1", GuildID: "g", Name: "Valorant"},
+		{ID: "2", GuildID: "g", Name: strings.Repeat("x", 100)},
+	}
+	rows := duplicateThreadsComponents(42, dups)
+	require.Len(t, rows, 1)
+	btns := rows[0].(discordgo.ActionsRow).Components
+	require.Len(t, btns, 3)
+
+	first := btns[0].(*discordgo.Button)
+	require.Equal(t, discordgo.LinkButton, first.Style)
+	require.Equal(t, "https://discord.com/channels/g/1", first.URL)
+	require.LessOrEqual(t, len([]rune(btns[1].(*discordgo.Button).Label)), 80)
+
+	anyway := btns[2].(*discordgo.Button)
+	require.Equal(t, "lfg_create_anyway::42", anyway.CustomID)
+}
@@ -3,6 +3,7 @@ package lfg
 import (
 	"fmt"
 	"gamerpal/internal/utils"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -16,12 +17,17 @@ func foundThreadsEmbed(fields []*discordgo.MessageEmbedField) *discordgo.Message
 	}
 }
 
-// createThreadSuggestionsEmbed creates an embed with game suggestions for thread creation
-func createThreadSuggestionsEmbed(suggestionsText string) *discordgo.MessageEmbed {
+// createThreadSuggestionsEmbed creates an embed with game suggestions for thread creation.
+// Fuzzy fallback results are labelled as such.
+func createThreadSuggestionsEmbed(suggestionsText string, fuzzy bool) *discordgo.MessageEmbed {
+	title, field := "Create a thread suggestions", "Suggestions"
+	if fuzzy {
+		title, field = "Create a thread: fuzzy matches", "Fuzzy matches"
+	}
 	return &discordgo.MessageEmbed{
-		Title:  "Create a thread suggestions",
+		Title:  title,
 		Color:  utils.Colors.Fancy(),
-		Fields: []*discordgo.MessageEmbedField{{Name: "Suggestions", Value: suggestionsText}},
+		Fields: []*discordgo.MessageEmbedField{{Name: field, Value: suggestionsText}},
 	}
 }
 
@@ -39,3 +45,38 @@ func threadCreatedEmbed(ch *discordgo.Channel, created bool) *discordgo.MessageE
 		},
 	}
 }
+
+// duplicateThreadsEmbed warns that threads with a colliding name already exist
+func duplicateThreadsEmbed(gameName string, dups []*discordgo.Channel) *discordgo.MessageEmbed {
+	var lines []string
+	for _, ch := range dups {
+		lines = append(lines, fmt.Sprintf("- %s", ch.Mention()))
+	}
+	return &discordgo.MessageEmbed{
+		Title:       "A similar thread already exists",
+		Description: fmt.Sprintf("Before creating a new thread for **%s**, check whether one of these is the same game so the community stays in one place.", gameName),
+		Color:       utils.Colors.Warning(),
+		Fields:      []*discordgo.MessageEmbedField{{Name: "Existing threads", Value: strings.Join(lines, "\n")}},
+	}
+}
+
+// duplicateThreadsComponents offers a link to each existing thread plus a
+// button to create the new thread anyway.
+func duplicateThreadsComponents(gameID int, dups []*discordgo.Channel) []discordgo.MessageComponent {
+	var btns []discordgo.MessageComponent
+	for _, ch := range dups {
+		btns = append(btns, &discordgo.Button{Style: discordgo.LinkButton, Label: truncateButtonLabel("Use " + ch.Name), URL: threadLink(ch)})
+	}
+	btns = append(btns, &discordgo.Button{Style: discordgo.SecondaryButton, Label: "Create anyway", CustomID: fmt.Sprintf("%s::%d", lfgCreateAnywayPrefix, gameID)})
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: btns}}
+}
+
+// truncateButtonLabel keeps labels within Discord's 80 character limit.
+func truncateButtonLabel(label string) string {
+	const maxLabel = 80
+	r := []rune(label)
+	if len(r) <= maxLabel {
+		return label
+	}
+	return string(r[:maxLabel-1]) + "…"
+}
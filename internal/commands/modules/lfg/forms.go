@@ -28,3 +28,36 @@ func buildLFGModal() *discordgo.InteractionResponse {
 		},
 	}
 }
+
+// extractModalGameName returns the game name typed into the LFG modal, or ""
+// if the input is missing or empty. Both value and pointer forms of
+// ActionsRow and TextInput are accepted so a discordgo decoding change can't
+// panic the handler.
+func extractModalGameName(components []discordgo.MessageComponent) string {
+	for _, comp := range components {
+		var row *discordgo.ActionsRow
+		switch v := comp.(type) {
+		case discordgo.ActionsRow:
+			row = &v
+		case *discordgo.ActionsRow:
+			row = v
+		default:
+			continue
+		}
+		for _, inner := range row.Components {
+			var ti *discordgo.TextInput
+			switch v := inner.(type) {
+			case discordgo.TextInput:
+				ti = &v
+			case *discordgo.TextInput:
+				ti = v
+			default:
+				continue
+			}
+			if ti != nil && ti.CustomID == lfgModalInputCustomID && ti.Value != "" {
+				return ti.Value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package lfg
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractModalGameName(t *testing.T) {
+	input := func(customID, value string) *discordgo.TextInput {
+		return &discordgo.TextInput{CustomID: customID, Value: value}
+	}
+
+	tests := []struct {
+		name       string
+		components []discordgo.MessageComponent
+		want       string
+	}{
+		{
+			name: "value ActionsRow",
+			components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{input(lfgModalInputCustomID, "Halo")}},
+			},
+			want: "Halo",
+		},
+		{
+			name: "pointer ActionsRow",
+			components: []discordgo.MessageComponent{
+				&discordgo.ActionsRow{Components: []discordgo.MessageComponent{input(lfgModalInputCustomID, "Portal 2")}},
+			},
+			want: "Portal 2",
+		},
+		{
+			name: "value TextInput",
+			components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{*input(lfgModalInputCustomID, "Tetris")}},
+			},
+			want: "Tetris",
+		},
+		{
+			name:       "missing input",
+			components: []discordgo.MessageComponent{discordgo.ActionsRow{}},
+			want:       "",
+		},
+		{
+			name:       "no components",
+			components: nil,
+			want:       "",
+		},
+		{
+			name: "wrong custom ID",
+			components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{input("something_else", "Halo")}},
+			},
+			want: "",
+		},
+		{
+			name: "empty value",
+			components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{input(lfgModalInputCustomID, "")}},
+			},
+			want: "",
+		},
+		{
+			name: "skips non-row components and nil inputs",
+			components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "x"},
+				&discordgo.ActionsRow{Components: []discordgo.MessageComponent{(*discordgo.TextInput)(nil), input(lfgModalInputCustomID, "Dota 2")}},
+			},
+			want: "Dota 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, extractModalGameName(tt.components))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package lfg
 import (
 	"bytes"
 	"fmt"
+	"gamerpal/internal/forumcache"
 	"gamerpal/internal/games"
 	"gamerpal/internal/utils"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Henry-Sarabia/igdb/v2"
 	"github.com/bwmarrin/discordgo"
@@ -24,9 +26,13 @@ const (
 	lfgModalCustomID          = "lfg_game_modal"
 	lfgModalInputCustomID     = "lfg_game_name"
 	lfgMoreSuggestionsPrefix  = "lfg_more_suggestions"  // lfg_more_suggestions::<normalizedQuery>
+	lfgSuggestionsPagePrefix  = "lfg_suggestions_page"  // lfg_suggestions_page::<page>::<query>
+	lfgFuzzyPagePrefix        = "lfg_fuzzy_page"        // lfg_fuzzy_page::<page>::<query>
 	lfgCreateSuggestionPrefix = "lfg_create_suggestion" // lfg_create_suggestion::<id>
+	lfgCreateAnywayPrefix     = "lfg_create_anyway"     // lfg_create_anyway::<id>
 	lfgNowAnyGamePrefix       = "lfg_now_any_game"      // lfg_now_any_game::<pendingKey>
 	lfgNowSpecificGamePrefix  = "lfg_now_specific_game" // lfg_now_specific_game::<pendingKey>
+	lfgNowStillLookingID      = "lfg_now_still_looking"
 )
 
 // handleLFG processes /lfg and /lfg-admin commands
@@ -46,6 +52,14 @@ func (m *Module) handleLFG(s *discordgo.Session, i *discordgo.InteractionCreate)
 		m.handleLFGNow(s, i)
 	case "refresh-thread-cache":
 		m.handleLFGRefreshCache(s, i)
+	case "clear-thread-cache":
+		m.handleLFGClearCache(s, i)
+	case "reconcile":
+		m.handleLFGReconcileCache(s, i)
+	case "trending":
+		m.handleLFGTrending(s, i)
+	case "migrate":
+		m.handleLFGMigrate(s, i)
 	default:
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ Unknown subcommand"}})
 	}
@@ -53,9 +67,10 @@ func (m *Module) handleLFG(s *discordgo.Session, i *discordgo.InteractionCreate)
 
 // handleLFGRefreshCache rebuilds the in-memory LFG thread cache (admin only command path).
 func (m *Module) handleLFGRefreshCache(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
-	introForum := m.config.GetGamerPalsIntroductionsForumChannelID() // optional second forum
-	guildID := m.config.GetGamerPalsServerID()
+	gcfg := m.config.PrimaryGuild()
+	guildID := gcfg.GuildID()
+	forumID := gcfg.GetGamerPalsLFGForumChannelID()
+	introForum := gcfg.GetGamerPalsIntroductionsForumChannelID() // optional second forum
 	if forumID == "" || guildID == "" {
 		_ = s.InteractionRespond(i.Interaction,
 			&discordgo.InteractionResponse{
@@ -94,8 +109,20 @@ func (m *Module) handleLFGRefreshCache(s *discordgo.Session, i *discordgo.Intera
 		}
 	}
 
+	var extraErr error
+	for _, extra := range gcfg.GetLFGForumChannelIDs()[1:] {
+		m.forumCache.RegisterForum(extra)
+		if err := m.forumCache.RefreshForum(guildID, extra); err != nil {
+			extraErr = err
+			lines = append(lines, fmt.Sprintf("LFG <#%s>: failed refresh", extra))
+			continue
+		}
+		stats, _ := m.forumCache.Stats(extra)
+		lines = append(lines, fmt.Sprintf("LFG <#%s>: threads=%d owners=%d", extra, stats.Threads, stats.OwnersTracked))
+	}
+
 	content := "✅ Forum cache refresh complete.\n" + strings.Join(lines, "\n")
-	if lfgErr != nil || introErr != nil {
+	if lfgErr != nil || introErr != nil || extraErr != nil {
 		content = "⚠️ Partial forum cache refresh.\n" + strings.Join(lines, "\n")
 	}
 
@@ -110,14 +137,70 @@ func (m *Module) handleLFGRefreshCache(s *discordgo.Session, i *discordgo.Intera
 	}
 }
 
+// handleLFGClearCache wipes one registered forum's cache and rebuilds it with
+// a full refresh, reporting stats from before and after.
+func (m *Module) handleLFGClearCache(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+
+	guildID := m.config.PrimaryGuild().GuildID()
+	var forumID string
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "forum" {
+			forumID = opt.ChannelValue(nil).ID
+		}
+	}
+	if guildID == "" || forumID == "" {
+		respond("❌ Missing guild or forum.")
+		return
+	}
+
+	before, registered := m.forumCache.Stats(forumID)
+	if !registered {
+		respond(fmt.Sprintf("❌ <#%s> isn't a cached forum.", forumID))
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}})
+
+	m.forumCache.ClearForum(forumID)
+	refreshErr := m.forumCache.RefreshForum(guildID, forumID)
+	after, _ := m.forumCache.Stats(forumID)
+
+	lines := []string{
+		fmt.Sprintf("Before: threads=%d owners=%d", before.Threads, before.OwnersTracked),
+		fmt.Sprintf("After: threads=%d owners=%d", after.Threads, after.OwnersTracked),
+	}
+	content := fmt.Sprintf("✅ Cleared and rebuilt the cache for <#%s>.\n%s", forumID, strings.Join(lines, "\n"))
+	if refreshErr != nil {
+		m.config.Logger.Warnf("LFG: rebuild after clearing forum %s failed: %v", forumID, refreshErr)
+		content = fmt.Sprintf("⚠️ Cleared the cache for <#%s> but the rebuild failed; it will refill from events and the next refresh.\n%s", forumID, strings.Join(lines, "\n"))
+	}
+	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content})
+
+	if i.Member != nil {
+		logMsg := fmt.Sprintf("%s cleared the forum cache for <#%s>. %s", i.Member.User.Mention(), forumID, strings.Join(lines, " | "))
+		if refreshErr != nil {
+			logMsg += " | rebuild failed"
+		}
+		if err := utils.LogToChannel(m.config, s, logMsg); err != nil {
+			m.config.Logger.Warnf("Failed to log forum cache clear: %v", err)
+		}
+	}
+}
+
 // handleGameThread searches for a game thread in the cache and returns a link or not found message.
 func (m *Module) handleGameThread(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
 	if forumID == "" {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ LFG forum channel ID not configured.",
+				Content: utils.T(i.Locale, utils.MsgLFGForumNotConfigured),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -142,7 +225,7 @@ func (m *Module) handleGameThread(s *discordgo.Session, i *discordgo.Interaction
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ Search query required.",
+				Content: utils.T(i.Locale, utils.MsgLFGSearchQueryRequired),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -151,16 +234,20 @@ func (m *Module) handleGameThread(s *discordgo.Session, i *discordgo.Interaction
 
 	normalized := strings.ToLower(searchQuery)
 
-	// Use forum cache exact + search
-	m.forumCache.RegisterForum(forumID) // idempotent
+	// Use forum cache exact + search across every LFG forum, main forum first
+	forumIDs := m.config.ForGuild(i.GuildID).GetLFGForumChannelIDs()
 	var threadID string
-	if exact, ok := m.forumCache.GetThreadByExactName(forumID, normalized); ok {
-		threadID = exact.ID
-	} else {
+	for _, id := range forumIDs {
+		m.forumCache.RegisterForum(id) // idempotent
+		if exact, ok := m.forumCache.GetThreadByExactName(id, normalized); ok && threadID == "" {
+			threadID, forumID = exact.ID, id
+		}
+	}
+	if threadID == "" {
 		// fallback to scored search buckets
-		results, ok2 := m.forumCache.SearchThreads(forumID, normalized, 5)
+		results, ok2 := m.forumCache.SearchThreadsAcross(forumIDs, normalized, 5)
 		if ok2 && len(results) > 0 {
-			threadID = results[0].ID // best candidate
+			threadID, forumID = results[0].ID, results[0].ForumID // best candidate
 		}
 	}
 
@@ -258,32 +345,38 @@ func (m *Module) handleGameThreadAutocomplete(s *discordgo.Session, i *discordgo
 	currentInput = strings.TrimSpace(strings.ToLower(currentInput))
 
 	var choices []*discordgo.ApplicationCommandOptionChoice
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
-	if forumID == "" {
+	gc := m.config.ForGuild(i.GuildID)
+	if gc.GetGamerPalsLFGForumChannelID() == "" {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionApplicationCommandAutocompleteResult})
 		return
 	}
-	m.forumCache.RegisterForum(forumID)
+	forumIDs := gc.GetLFGForumChannelIDs()
+	for _, id := range forumIDs {
+		m.forumCache.RegisterForum(id)
+	}
 
 	if currentInput == "" {
-		// List threads (up to 25 newest) via forum cache
-		threads, ok := m.forumCache.ListThreads(forumID)
-		if ok {
-			sort.Slice(threads, func(i, j int) bool {
-				if threads[i].CreatedAt.Equal(threads[j].CreatedAt) {
-					return threads[i].ID > threads[j].ID
-				}
-				return threads[i].CreatedAt.After(threads[j].CreatedAt)
-			})
-			for i, tm := range threads {
-				if i >= 25 {
-					break
-				}
-				choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: tm.Name, Value: tm.Name})
+		// List threads (up to 25 newest across LFG forums) via forum cache
+		var threads []*forumcache.ThreadMeta
+		for _, id := range forumIDs {
+			if list, ok := m.forumCache.ListThreads(id); ok {
+				threads = append(threads, list...)
+			}
+		}
+		sort.Slice(threads, func(i, j int) bool {
+			if threads[i].CreatedAt.Equal(threads[j].CreatedAt) {
+				return threads[i].ID > threads[j].ID
+			}
+			return threads[i].CreatedAt.After(threads[j].CreatedAt)
+		})
+		for i, tm := range threads {
+			if i >= 25 {
+				break
 			}
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: tm.Name, Value: tm.Name})
 		}
 	} else {
-		results, ok := m.forumCache.SearchThreads(forumID, currentInput, 25)
+		results, ok := m.forumCache.SearchThreadsAcross(forumIDs, currentInput, 25)
 		if ok {
 			for _, tm := range results {
 				choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: tm.Name, Value: tm.Name})
@@ -296,9 +389,9 @@ func (m *Module) handleGameThreadAutocomplete(s *discordgo.Session, i *discordgo
 
 // handleLFGSetup posts (or replaces) the LFG panel in the current channel.
 func (m *Module) handleLFGSetup(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
 	if forumID == "" {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ LFG forum channel ID not configured.", Flags: discordgo.MessageFlagsEphemeral}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGForumNotConfigured), Flags: discordgo.MessageFlagsEphemeral}})
 		return
 	}
 
@@ -323,10 +416,12 @@ func (m *Module) handleLFGSetup(s *discordgo.Session, i *discordgo.InteractionCr
 }
 
 // createLFGThreadFromExactMatch builds metadata + creates the forum thread for an exact IGDB match.
-func (m *Module) createLFGThreadFromExactMatch(forumID string, exact *igdb.Game) (*discordgo.Channel, error) {
+func (m *Module) createLFGThreadFromExactMatch(guildID, forumID string, exact *igdb.Game) (*discordgo.Channel, error) {
 	if exact == nil {
 		return nil, fmt.Errorf("nil exact game")
 	}
+	gc := m.config.ForGuild(guildID)
+	autoArchive := gc.GetLFGThreadAutoArchive()
 	displayName := exact.Name
 	var gameSummary string
 	var playerLine string
@@ -378,13 +473,15 @@ func (m *Module) createLFGThreadFromExactMatch(forumID string, exact *igdb.Game)
 	}
 
 	// Fetch cover art (used by Discord as forum thread preview if placed first in initial message)
-	// We intentionally keep this lightweight; a cache could be added later if needed.
 	// IGDB Game struct's Cover field is an ID referencing a cover resource containing image_id.
 	if exact.Cover > 0 { // Cover is present
-		if covers, err := m.igdbClient.Covers.List([]int{exact.Cover}, igdb.SetFields("image_id")); err == nil {
+		if cached, ok := m.coverURLs.Get(exact.Cover); ok {
+			coverURL = cached
+		} else if covers, err := m.igdbClient.Covers.List([]int{exact.Cover}, igdb.SetFields("image_id")); err == nil {
 			if len(covers) > 0 && covers[0] != nil && covers[0].ImageID != "" {
 				// Use a medium/large preset; can adjust size variant if needed (t_cover_big, t_1080p, etc.)
 				coverURL = fmt.Sprintf("https://images.igdb.com/igdb/image/upload/t_cover_big/%s.jpg", covers[0].ImageID)
+				m.coverURLs.Set(exact.Cover, coverURL)
 			}
 		} else {
 			m.config.Logger.Debugf("LFG: failed fetching cover for '%s': %v", displayName, err)
@@ -446,7 +543,7 @@ func (m *Module) createLFGThreadFromExactMatch(forumID string, exact *igdb.Game)
 				forumID,
 				&discordgo.ThreadStart{ // basic thread metadata
 					Name:                displayName,
-					AutoArchiveDuration: 4320,
+					AutoArchiveDuration: autoArchive,
 				},
 				&discordgo.MessageSend{
 					Content: initialContent,
@@ -463,12 +560,21 @@ func (m *Module) createLFGThreadFromExactMatch(forumID string, exact *igdb.Game)
 	}
 
 	if thread == nil { // fallback simple creation
-		thread, err = m.session.ForumThreadStart(forumID, displayName, 4320, initialContent)
+		thread, err = m.session.ForumThreadStart(forumID, displayName, autoArchive, initialContent)
 		if err != nil {
 			m.config.Logger.Errorf("LFG: failed creating forum thread '%s' in forum %s: %v", displayName, forumID, err)
 			return nil, err
 		}
 	}
+	m.config.Logger.Infof("LFG: created thread '%s' (%s) in forum %s with auto-archive %dm", displayName, thread.ID, forumID, autoArchive)
+
+	// A forum thread's starter message shares the thread's ID. Pinning is
+	// best-effort; a missing permission must not fail thread creation.
+	if gc.GetLFGPinStarterMessage() {
+		if err := m.session.ChannelMessagePin(thread.ID, thread.ID); err != nil {
+			m.config.Logger.Warnf("LFG: failed to pin starter message in thread '%s' (%s): %v", displayName, thread.ID, err)
+		}
+	}
 	return thread, nil
 }
 
@@ -486,29 +592,63 @@ func threadLink(ch *discordgo.Channel) string {
 	return fmt.Sprintf("https://discord.com/channels/%s/%s", ch.GuildID, ch.ID)
 }
 
-func (m *Module) findCachedExactThread(forumID, normalized string) (*discordgo.Channel, bool) {
-	if forumID == "" || normalized == "" {
+// findCachedExactThread returns the live thread whose name exactly matches
+// normalized, checking forums in order so the main LFG forum wins a tie.
+func (m *Module) findCachedExactThread(forumIDs []string, normalized string) (*discordgo.Channel, bool) {
+	if normalized == "" {
 		return nil, false
 	}
-	m.forumCache.RegisterForum(forumID)
-	meta, ok := m.forumCache.GetThreadByExactName(forumID, normalized)
-	if !ok || meta == nil {
-		return nil, false
+	for _, forumID := range forumIDs {
+		m.forumCache.RegisterForum(forumID)
+		meta, ok := m.forumCache.GetThreadByExactName(forumID, normalized)
+		if !ok || meta == nil {
+			continue
+		}
+		if ch, ok := m.threadChannel(meta.ID, forumID); ok {
+			return ch, true
+		}
 	}
-	ch, err := m.session.Channel(meta.ID)
-	if err != nil || ch == nil || ch.ParentID != forumID {
-		return nil, false // stale or not found
+	return nil, false // stale or not found
+}
+
+// threadChannel resolves a thread ID from the forum cache to its channel,
+// reusing a recent lookup when possible. It reports false when the thread is
+// gone or no longer lives under forumID.
+func (m *Module) threadChannel(threadID, forumID string) (*discordgo.Channel, bool) {
+	ch, ok := m.threadChannels.Get(threadID)
+	if !ok {
+		var err error
+		ch, err = m.session.Channel(threadID)
+		if err != nil || ch == nil {
+			return nil, false
+		}
+		m.threadChannels.Set(threadID, ch)
+	}
+	if ch.ParentID != forumID {
+		return nil, false
 	}
 	return ch, true
 }
 
+// threadRateLimitedError is returned when creating a thread would exceed the
+// requester's thread creation limit.
+type threadRateLimitedError struct {
+	RetryAt time.Time
+}
+
+func (e *threadRateLimitedError) Error() string {
+	return fmt.Sprintf("thread creation limit reached; retry after %s", e.RetryAt.UTC().Format(time.RFC3339))
+}
+
 // lookupOrCreateGameThread is the shared find-or-create primitive used by
-// the LLM agent tool. Returns the resolved channel (existing or newly
+// the LLM agent tool. It searches every forum in forumIDs and creates new
+// threads in the first, counting creations against userID's thread creation
+// limit unless isMod. Returns the resolved channel (existing or newly
 // created), whether it was created, and any IGDB suggestions when the name
 // is ambiguous. All zero values means no IGDB match.
-func (m *Module) lookupOrCreateGameThread(forumID, name string) (ch *discordgo.Channel, created bool, suggestions []*igdb.Game, err error) {
+func (m *Module) lookupOrCreateGameThread(forumIDs []string, name, userID string, isMod bool) (ch *discordgo.Channel, created bool, suggestions []*igdb.Game, err error) {
 	normalized := strings.ToLower(strings.TrimSpace(name))
-	if existing, ok := m.findCachedExactThread(forumID, normalized); ok && existing != nil {
+	if existing, ok := m.findCachedExactThread(forumIDs, normalized); ok && existing != nil {
 		return existing, false, nil, nil
 	}
 	res, err := games.ExactMatchWithSuggestions(m.igdbClient, name)
@@ -521,12 +661,21 @@ func (m *Module) lookupOrCreateGameThread(forumID, name string) (ch *discordgo.C
 	if res.ExactMatch != nil {
 		canonical := strings.ToLower(res.ExactMatch.Name)
 		if canonical != normalized {
-			if existing, ok := m.findCachedExactThread(forumID, canonical); ok && existing != nil {
+			if existing, ok := m.findCachedExactThread(forumIDs, canonical); ok && existing != nil {
 				return existing, false, nil, nil
 			}
 		}
-		newCh, err := m.createLFGThreadFromExactMatch(forumID, res.ExactMatch)
+		if dups := m.nearDuplicateThreads(forumIDs, res.ExactMatch.Name); len(dups) > 0 {
+			return dups[0], false, nil, nil
+		}
+		guildID := m.config.GetGamerPalsServerID()
+		release, ok, retryAt := m.reserveThreadCreate(guildID, userID, isMod)
+		if !ok {
+			return nil, false, nil, &threadRateLimitedError{RetryAt: retryAt}
+		}
+		newCh, err := m.createLFGThreadFromExactMatch(guildID, forumIDs[0], res.ExactMatch)
 		if err != nil {
+			release()
 			return nil, false, nil, err
 		}
 		return newCh, true, nil, nil
@@ -534,21 +683,23 @@ func (m *Module) lookupOrCreateGameThread(forumID, name string) (ch *discordgo.C
 	return nil, false, res.Suggestions, nil
 }
 
-// searchForumThreads resolves cached search hits to live channel handles,
-// dropping anything stale or moved out of the forum.
-func (m *Module) searchForumThreads(forumID, query string, limit int) []*discordgo.Channel {
-	if forumID == "" || strings.TrimSpace(query) == "" || limit <= 0 {
+// searchForumThreads resolves cached search hits across forumIDs to live
+// channel handles, dropping anything stale or moved out of its forum.
+func (m *Module) searchForumThreads(forumIDs []string, query string, limit int) []*discordgo.Channel {
+	if strings.TrimSpace(query) == "" || limit <= 0 {
 		return nil
 	}
-	m.forumCache.RegisterForum(forumID)
-	hits, ok := m.forumCache.SearchThreads(forumID, query, limit)
+	for _, forumID := range forumIDs {
+		m.forumCache.RegisterForum(forumID)
+	}
+	hits, ok := m.forumCache.SearchThreadsAcross(forumIDs, query, limit)
 	if !ok {
 		return nil
 	}
 	out := make([]*discordgo.Channel, 0, len(hits))
 	for _, meta := range hits {
-		ch, err := m.session.Channel(meta.ID)
-		if err != nil || ch == nil || ch.ParentID != forumID {
+		ch, ok := m.threadChannel(meta.ID, meta.ForumID)
+		if !ok {
 			continue
 		}
 		out = append(out, ch)
@@ -556,13 +707,15 @@ func (m *Module) searchForumThreads(forumID, query string, limit int) []*discord
 	return out
 }
 
-func (m *Module) gatherPartialThreadSuggestionsDetailed(forumID, searchTerm, excludeThreadID string, limit int) []discordgo.Channel {
+func (m *Module) gatherPartialThreadSuggestionsDetailed(forumIDs []string, searchTerm, excludeThreadID string, limit int) []discordgo.Channel {
 	searchTerm = strings.TrimSpace(strings.ToLower(searchTerm))
-	if forumID == "" || searchTerm == "" || limit <= 0 {
+	if searchTerm == "" || limit <= 0 {
 		return nil
 	}
-	m.forumCache.RegisterForum(forumID)
-	results, ok := m.forumCache.SearchThreads(forumID, searchTerm, limit+5) // fetch a little extra for exclusion filtering
+	for _, forumID := range forumIDs {
+		m.forumCache.RegisterForum(forumID)
+	}
+	results, ok := m.forumCache.SearchThreadsAcross(forumIDs, searchTerm, limit+5) // fetch a little extra for exclusion filtering
 	if !ok || len(results) == 0 {
 		return nil
 	}
@@ -571,8 +724,8 @@ func (m *Module) gatherPartialThreadSuggestionsDetailed(forumID, searchTerm, exc
 		if meta.ID == excludeThreadID { // skip exact already shown
 			continue
 		}
-		ch, err := m.session.Channel(meta.ID)
-		if err != nil || ch == nil || ch.ParentID != forumID {
+		ch, ok := m.threadChannel(meta.ID, meta.ForumID)
+		if !ok {
 			continue
 		}
 		out = append(out, *ch)
@@ -0,0 +1,61 @@
+package lfg
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// errIGDBClientMissing is the cause reported when no IGDB client was configured.
+var errIGDBClientMissing = errors.New("igdb client is not initialized")
+
+// igdbAlertCooldown is the minimum time between IGDB outage alerts in the log
+// channel, so a long outage is reported once rather than per interaction.
+const igdbAlertCooldown = time.Hour
+
+// outageAlerter rate-limits outage alerts. The zero value is ready to use.
+type outageAlerter struct {
+	mu   sync.Mutex
+	last time.Time
+}
+
+// shouldAlert reports whether an alert may be sent at now and, if so, records it.
+func (a *outageAlerter) shouldAlert(now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.last.IsZero() && now.Sub(a.last) < igdbAlertCooldown {
+		return false
+	}
+	a.last = now
+	return true
+}
+
+// igdbUnavailable is the shared failure path for every IGDB entry point. It
+// logs the cause, alerts the log channel at most once per igdbAlertCooldown,
+// and shows the user a friendly message through respond, which sends it the
+// way the entry point needs (deferred edit, message update, ...).
+func (m *Module) igdbUnavailable(s *discordgo.Session, i *discordgo.InteractionCreate, cause error, respond func(content string)) {
+	m.config.Logger.Errorf("LFG: IGDB unavailable: %v", cause)
+	if m.igdbAlerts.shouldAlert(time.Now()) {
+		msg := fmt.Sprintf("⚠️ IGDB game lookup is failing for members: %v\nFurther failures in the next %s won't be reported. `/refresh-igdb` may help if the token expired.", cause, igdbAlertCooldown)
+		if err := utils.LogToChannel(m.config, s, msg); err != nil {
+			m.config.Logger.Warnf("LFG: failed to log IGDB outage: %v", err)
+		}
+	}
+	respond(utils.T(i.Locale, utils.MsgLFGGameLookupUnavailable))
+}
+
+// updateMessageContent replaces a component's message with plain content.
+func updateMessageContent(s *discordgo.Session, i *discordgo.InteractionCreate) func(string) {
+	return func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{Content: content, Embeds: []*discordgo.MessageEmbed{}, Components: []discordgo.MessageComponent{}},
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package lfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutageAlerter(t *testing.T) {
+	var a outageAlerter
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	require.True(t, a.shouldAlert(start), "first failure alerts")
+	require.False(t, a.shouldAlert(start.Add(time.Minute)), "repeat failures within the cooldown stay quiet")
+	require.False(t, a.shouldAlert(start.Add(igdbAlertCooldown-time.Second)))
+	require.True(t, a.shouldAlert(start.Add(igdbAlertCooldown)), "a long outage is reported again after the cooldown")
+}
@@ -6,7 +6,6 @@ import (
 	"gamerpal/internal/utils"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/Henry-Sarabia/igdb/v2"
 	"github.com/bwmarrin/discordgo"
@@ -22,14 +21,17 @@ func (m *Module) handleLFGComponent(s *discordgo.Session, i *discordgo.Interacti
 		if err := s.InteractionRespond(i.Interaction, modal); err != nil {
 			m.config.Logger.Errorf("LFG: failed to open modal: %v", err)
 		}
-	case strings.HasPrefix(cid, lfgMoreSuggestionsPrefix+"::"):
+	case strings.HasPrefix(cid, lfgMoreSuggestionsPrefix+"::"), strings.HasPrefix(cid, lfgSuggestionsPagePrefix+"::"),
+		strings.HasPrefix(cid, lfgFuzzyPagePrefix+"::"):
 		m.handleMoreSuggestions(s, i)
-	case strings.HasPrefix(cid, lfgCreateSuggestionPrefix+"::"):
+	case strings.HasPrefix(cid, lfgCreateSuggestionPrefix+"::"), strings.HasPrefix(cid, lfgCreateAnywayPrefix+"::"):
 		m.handleCreateSuggestionThread(s, i)
 	case strings.HasPrefix(cid, lfgNowAnyGamePrefix+"::"):
 		m.handleLFGNowAnyGame(s, i)
 	case strings.HasPrefix(cid, lfgNowSpecificGamePrefix+"::"):
 		m.handleLFGNowSpecificGame(s, i)
+	case cid == lfgNowStillLookingID:
+		m.handleLFGNowStillLooking(s, i)
 	default:
 		// ignore
 	}
@@ -40,41 +42,19 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 	if i.ModalSubmitData().CustomID != lfgModalCustomID {
 		return
 	}
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
-	if forumID == "" {
+	gc := m.config.ForGuild(i.GuildID)
+	if gc.GetGamerPalsLFGForumChannelID() == "" {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ LFG forum channel ID not configured.",
+				Content: utils.T(i.Locale, utils.MsgLFGForumNotConfigured),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
-	var gameName string
-	// Safely extract text input value; handle both value and pointer forms of ActionsRow to avoid panics.
-	for _, comp := range i.ModalSubmitData().Components {
-		var row *discordgo.ActionsRow
-		switch v := comp.(type) {
-		case discordgo.ActionsRow:
-			row = &v
-		case *discordgo.ActionsRow:
-			row = v
-		default:
-			continue
-		}
-		for _, inner := range row.Components {
-			if ti, ok := inner.(*discordgo.TextInput); ok && ti.CustomID == lfgModalInputCustomID {
-				gameName = ti.Value
-				break
-			}
-		}
-		if gameName != "" { // found
-			break
-		}
-	}
-
+	gameName := extractModalGameName(i.ModalSubmitData().Components)
 	if gameName == "" {
 		// Log for diagnostics in case modal structure changes unexpectedly
 		m.config.Logger.Warnf("LFG modal submit: game name input not found in components; customID=%s", i.ModalSubmitData().CustomID)
@@ -85,13 +65,14 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ Game name required.", Flags: discordgo.MessageFlagsEphemeral,
+				Content: utils.T(i.Locale, utils.MsgLFGGameNameRequired), Flags: discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
 	normalized := strings.ToLower(gameName)
+	forumIDs := gc.GetLFGForumChannelIDs()
 
 	// Defer ephemeral response while we work
 	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -104,32 +85,30 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 		return
 	}
 
+	editContent := func(content string) {
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: &content}, true)
+	}
 	if m.igdbClient == nil {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-			Content: new("❌ IGDB client is not initialized. Admin intervention required"),
-		})
+		m.igdbUnavailable(s, i, errIGDBClientMissing, editContent)
 		return
 	}
 
 	// 1. Attempt to find existing thread from cache (validated)
-	exactThreadChannel, _ := m.findCachedExactThread(forumID, normalized)
+	exactThreadChannel, _ := m.findCachedExactThread(forumIDs, normalized)
 
 	// 2. Perform search (exact + suggestions)
 	searchRes, err := games.ExactMatchWithSuggestions(m.igdbClient, gameName)
 	if err != nil {
-		m.config.Logger.Errorf("LFG: failed to search IGDB for '%s': %v", gameName, err)
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-			Content: new(fmt.Sprintf("❌ error looking up game _\"%s\"_", gameName)),
-		})
+		m.igdbUnavailable(s, i, fmt.Errorf("search for %q: %w", gameName, err), editContent)
 		return
 	}
 	if searchRes == nil {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ unexpected empty search result")})
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: new("❌ unexpected empty search result")}, true)
 		return
 	}
 
 	// 3. Gather partial thread suggestions (cache partial matches) up to 3 (only existing threads shown initially)
-	partialThreadSuggestions := m.gatherPartialThreadSuggestionsDetailed(forumID, normalized, idOrEmpty(exactThreadChannel), 3)
+	partialThreadSuggestions := m.gatherPartialThreadSuggestionsDetailed(forumIDs, normalized, idOrEmpty(exactThreadChannel), 3)
 
 	// Print exact match threads first
 	var fields []*discordgo.MessageEmbedField
@@ -154,6 +133,7 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 
 	// Add Show More Suggestions button if we likely have more IGDB suggestions (searchRes.Suggestions length > 0 after filtering duplicates/exact)
 	var components []discordgo.MessageComponent
+	fuzzyOffered := false
 	if len(searchRes.Suggestions) > 0 || searchRes.ExactMatch != nil {
 		components = []discordgo.MessageComponent{
 			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
@@ -163,6 +143,24 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 		fields = append(fields, &discordgo.MessageEmbedField{
 			Name: "Click \"Create a thread\" to find more options and create a thread!",
 		})
+	} else if cid := suggestionsPageCustomID(0, gameName, true); cid != "" {
+		// Nothing matched the title closely; fall back to IGDB's looser
+		// full-text search so misspelled or obscure titles still get options.
+		fuzzy, err := games.FuzzySearch(m.igdbClient, gameName, 1)
+		if err != nil {
+			m.config.Logger.Warnf("LFG: fuzzy search for %q failed: %v", gameName, err)
+		}
+		if len(fuzzy) > 0 {
+			fuzzyOffered = true
+			components = []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					&discordgo.Button{Style: discordgo.SecondaryButton, Label: "Show fuzzy matches", CustomID: cid},
+				}},
+			}
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name: "No game matched that title. Click \"Show fuzzy matches\" to pick from similar titles and create a thread!",
+			})
+		}
 	}
 
 	// Log the search and threads shown to user
@@ -185,6 +183,9 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 	} else {
 		logDescription += "\n\n**No threads found**"
 	}
+	if fuzzyOffered {
+		logDescription += "\n\n_No IGDB match; fuzzy matches offered._"
+	}
 
 	if err := utils.LogToChannel(m.config, s, logDescription); err != nil {
 		m.config.Logger.Errorf("LFG: failed to log search results: %v", err)
@@ -193,66 +194,53 @@ func (m *Module) handleLFGModalSubmit(s *discordgo.Session, i *discordgo.Interac
 	embed := foundThreadsEmbed(fields)
 
 	embedSlice := []*discordgo.MessageEmbed{embed}
-	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Embeds: &embedSlice, Components: &components})
+	_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Embeds: &embedSlice, Components: &components}, true)
 }
 
-// handleMoreSuggestions builds an embed with up to 9 IGDB title suggestions and buttons (1-5) to create threads.
+// handleMoreSuggestions shows one page of IGDB title suggestions with numbered
+// buttons to create a thread, plus a "More options" button while further
+// pages remain.
 func (m *Module) handleMoreSuggestions(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if m.igdbClient == nil {
+		m.igdbUnavailable(s, i, errIGDBClientMissing, updateMessageContent(s, i))
 		return
 	}
-	cid := i.MessageComponentData().CustomID
-	parts := strings.SplitN(cid, "::", 2)
-	if len(parts) != 2 {
-		return
-	}
-	gameName := parts[1]
-	// Re-run search for suggestions
-	searchRes, err := games.ExactMatchWithSuggestions(m.igdbClient, gameName)
-	if err != nil {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: fmt.Sprintf("❌ error fetching suggestions: %v", err)}})
+	gameName, page, fuzzy, ok := parseSuggestionsCustomID(i.MessageComponentData().CustomID)
+	if !ok {
 		return
 	}
+	maxSuggestions := m.config.ForGuild(i.GuildID).GetLFGMaxSuggestions()
 
 	var gameSuggestions []*igdb.Game
-	if searchRes != nil {
-		if searchRes.ExactMatch != nil {
-			gameSuggestions = append(gameSuggestions, searchRes.ExactMatch)
+	if fuzzy {
+		found, err := games.FuzzySearch(m.igdbClient, gameName, maxSuggestions)
+		if err != nil {
+			m.igdbUnavailable(s, i, fmt.Errorf("fuzzy matches for %q: %w", gameName, err), updateMessageContent(s, i))
+			return
 		}
-		if len(searchRes.Suggestions) > 0 {
-			gameSuggestions = append(gameSuggestions, searchRes.Suggestions...)
-		}
-	}
-
-	if len(gameSuggestions) == 0 {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "No further suggestions available."}})
-		return
-	}
-
-	// Collect up to 5 suggestion games (with potential duplicate names distinguished by year)
-	var picked []*igdb.Game
-	seen := make(map[string]struct{})
-	for _, g := range gameSuggestions {
-		if g == nil || g.Name == "" {
-			continue
-		}
-		// Compute release year (0 if unknown) for dedupe key
-		year := 0
-		if g.FirstReleaseDate > 0 {
-			year = time.Unix(int64(g.FirstReleaseDate), 0).UTC().Year()
-		}
-		key := strings.ToLower(g.Name) + "::" + strconv.Itoa(year)
-		if _, exists := seen[key]; exists {
-			continue // duplicate title+year, skip
+		gameSuggestions = found
+	} else {
+		// Re-run search for suggestions
+		searchRes, err := games.ExactMatchWithSuggestionsLimit(m.igdbClient, gameName, maxSuggestions)
+		if err != nil {
+			m.igdbUnavailable(s, i, fmt.Errorf("suggestions for %q: %w", gameName, err), updateMessageContent(s, i))
+			return
 		}
-		seen[key] = struct{}{}
-		picked = append(picked, g)
-		if len(picked) >= 5 {
-			break
+		if searchRes != nil {
+			if searchRes.ExactMatch != nil {
+				gameSuggestions = append(gameSuggestions, searchRes.ExactMatch)
+			}
+			if len(searchRes.Suggestions) > 0 {
+				gameSuggestions = append(gameSuggestions, searchRes.Suggestions...)
+			}
 		}
 	}
+
+	// Dedupe the whole result set (duplicate titles distinguished by year) before paging
+	all := dedupeSuggestions(gameSuggestions, maxSuggestions)
+	picked, hasMore := suggestionPage(all, page)
 	if len(picked) == 0 {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "No further suggestions available."}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGNoMoreSuggestions)}})
 		return
 	}
 
@@ -261,45 +249,60 @@ func (m *Module) handleMoreSuggestions(s *discordgo.Session, i *discordgo.Intera
 	for idx, g := range picked {
 		btns = append(btns, &discordgo.Button{Style: discordgo.PrimaryButton, Label: fmt.Sprintf("%d", idx+1), CustomID: fmt.Sprintf("%s::%d", lfgCreateSuggestionPrefix, g.ID)})
 	}
-	components := []discordgo.MessageComponent{}
-	if len(btns) > 0 {
-		components = append(components, discordgo.ActionsRow{Components: btns})
+	components := []discordgo.MessageComponent{discordgo.ActionsRow{Components: btns}}
+	if cid := suggestionsPageCustomID(page+1, gameName, fuzzy); hasMore && cid != "" {
+		components = append(components, discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.Button{Style: discordgo.SecondaryButton, Label: "More options", CustomID: cid},
+		}})
 	}
 
 	// Build suggestion list text with year (from first_release_date) when available
 	var listBuilder strings.Builder
 	var gameNames []string
+	if fuzzy {
+		listBuilder.WriteString(fmt.Sprintf("Nothing matched **%s** exactly. These are fuzzy matches, so double-check the title.\n\n", gameName))
+	}
 	for i, g := range picked {
 		yearStr := ""
-		if g.FirstReleaseDate > 0 { // epoch seconds
-			y := time.Unix(int64(g.FirstReleaseDate), 0).UTC().Year()
+		if y := releaseYear(g); y > 0 {
 			yearStr = fmt.Sprintf(" (%d)", y)
 		}
 		gameName := fmt.Sprintf("%s%s", g.Name, yearStr)
 		listBuilder.WriteString(fmt.Sprintf("%d. %s\n", i+1, gameName))
 		gameNames = append(gameNames, gameName)
 	}
-	listBuilder.WriteString("\nClick a numbered button (1-5) below to create a thread for that game.")
+	listBuilder.WriteString(fmt.Sprintf("\nClick a numbered button (1-%d) below to create a thread for that game.", len(picked)))
+	pages := (len(all) + suggestionsPerPage - 1) / suggestionsPerPage
+	if pages > 1 {
+		listBuilder.WriteString(fmt.Sprintf("\nPage %d of %d", page+1, pages))
+	}
 
 	// Log the game suggestions shown to the user
 	userMention := "Member"
 	if i.Member != nil {
 		userMention = i.Member.Mention()
 	}
-	logDescription := fmt.Sprintf("%s clicked to create a thread for **\"%s\"**\n\n**Game suggestions shown:**\n• %s",
-		userMention, gameName, strings.Join(gameNames, "\n• "))
+	kind := "Game suggestions"
+	if fuzzy {
+		kind = "Fuzzy matches"
+	}
+	logDescription := fmt.Sprintf("%s clicked to create a thread for **\"%s\"**\n\n**%s shown (page %d):**\n• %s",
+		userMention, gameName, kind, page+1, strings.Join(gameNames, "\n• "))
 	if err := utils.LogToChannel(m.config, s, logDescription); err != nil {
 		m.config.Logger.Errorf("LFG: failed to log game suggestions: %v", err)
 	}
 
-	embed := createThreadSuggestionsEmbed(listBuilder.String())
+	embed := createThreadSuggestionsEmbed(listBuilder.String(), fuzzy)
 	embedSlice := []*discordgo.MessageEmbed{embed}
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Embeds: embedSlice, Components: components}})
 }
 
 // handleCreateSuggestionThread creates a thread for selected suggestion and updates message with final embed.
+// If a thread with a colliding name already exists, the user is pointed at it
+// instead, with a "Create anyway" button that re-enters here and skips the check.
 func (m *Module) handleCreateSuggestionThread(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if m.igdbClient == nil {
+		m.igdbUnavailable(s, i, errIGDBClientMissing, updateMessageContent(s, i))
 		return
 	}
 	cid := i.MessageComponentData().CustomID
@@ -307,40 +310,74 @@ func (m *Module) handleCreateSuggestionThread(s *discordgo.Session, i *discordgo
 	if len(parts) != 2 {
 		return
 	}
+	force := parts[0] == lfgCreateAnywayPrefix
 	gameIDStr := parts[1]
 	gameID, err := strconv.Atoi(gameIDStr)
 	if err != nil || gameID <= 0 {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "❌ Invalid suggestion."}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGInvalidSuggestion)}})
 		return
 	}
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
 	if forumID == "" {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "❌ LFG forum channel ID not configured."}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGForumNotConfigured)}})
 		return
 	}
 
 	// Fetch the specific game by ID to ensure correctness when duplicate titles exist.
 	gamesList, err := m.igdbClient.Games.List([]int{gameID}, igdb.SetFields("id", "name", "summary", "websites", "multiplayer_modes", "cover", "first_release_date"))
-	if err != nil || len(gamesList) == 0 || gamesList[0] == nil {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "❌ Unable to fetch game details."}})
+	if err != nil {
+		m.igdbUnavailable(s, i, fmt.Errorf("fetch game %d: %w", gameID, err), updateMessageContent(s, i))
+		return
+	}
+	if len(gamesList) == 0 || gamesList[0] == nil {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGGameDetailsUnavailable)}})
 		return
 	}
 	game := gamesList[0]
 	if game.Name == "" {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "❌ Game has no name."}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGGameHasNoName)}})
 		return
 	}
 
+	forumIDs := m.config.ForGuild(i.GuildID).GetLFGForumChannelIDs()
 	norm := strings.ToLower(game.Name)
-	if ch, exists := m.findCachedExactThread(forumID, norm); exists {
+	if ch, exists := m.findCachedExactThread(forumIDs, norm); exists {
 		m.logThreadCreationOutcome(i, game.Name, ch, false)
 		m.finalizeSuggestionThreadResponse(i, ch, false)
 		return
 	}
+	if !force {
+		if dups := m.nearDuplicateThreads(forumIDs, game.Name); len(dups) > 0 {
+			m.logDuplicateWarning(i, game.Name, dups)
+			embed := duplicateThreadsEmbed(game.Name, dups)
+			components := duplicateThreadsComponents(game.ID, dups)
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Components: components}})
+			return
+		}
+	}
+
+	// Per-user creation rate limit (moderators exempt)
+	userID := ""
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	}
+	isMod := i.Member != nil && i.Member.Permissions&discordgo.PermissionBanMembers != 0
+	release, ok, retryAt := m.reserveThreadCreate(i.GuildID, userID, isMod)
+	if !ok {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: utils.T(i.Locale, utils.MsgLFGThreadRateLimited, retryAt.Unix()),
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		return
+	}
 
-	ch, err := m.createLFGThreadFromExactMatch(forumID, game)
+	ch, err := m.createLFGThreadFromExactMatch(i.GuildID, forumID, game)
 	if err != nil {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: "❌ Failed creating thread."}})
+		release()
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGThreadCreateFailed)}})
 		return
 	}
 	m.logThreadCreationOutcome(i, game.Name, ch, true)
@@ -354,6 +391,23 @@ func (m *Module) finalizeSuggestionThreadResponse(i *discordgo.InteractionCreate
 	_ = m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: &discordgo.InteractionResponseData{Embeds: embedSlice}})
 }
 
+// logDuplicateWarning logs when thread creation was paused over a name collision
+func (m *Module) logDuplicateWarning(i *discordgo.InteractionCreate, gameName string, dups []*discordgo.Channel) {
+	userMention := "Member"
+	if i.Member != nil {
+		userMention = i.Member.Mention()
+	}
+	var mentions []string
+	for _, ch := range dups {
+		mentions = append(mentions, ch.Mention())
+	}
+	logDescription := fmt.Sprintf("%s selected **\"%s\"** but similar threads already exist\n\n**Threads shown:**\n• %s",
+		userMention, gameName, strings.Join(mentions, "\n• "))
+	if err := utils.LogToChannel(m.config, m.session, logDescription); err != nil {
+		m.config.Logger.Errorf("LFG: failed to log duplicate thread warning: %v", err)
+	}
+}
+
 // logThreadCreationOutcome logs when a user selects a game and the outcome
 func (m *Module) logThreadCreationOutcome(i *discordgo.InteractionCreate, gameName string, ch *discordgo.Channel, created bool) {
 	userMention := "Member"
@@ -0,0 +1,410 @@
+package lfg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/games"
+	"gamerpal/internal/utils"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// defaultMigrateLimit is how many threads one migrate run looks up when limit isn't given
+	defaultMigrateLimit = 50
+	// maxMigrateLimit caps a single run; every thread costs two IGDB queries
+	maxMigrateLimit = 200
+	// migrateBudget is how long a run may take before it stops looking up
+	// threads. The interaction token that delivers the result lasts 15 minutes.
+	migrateBudget = 12 * time.Minute
+	// migrateRenameCost is a conservative estimate of one rename in execute
+	// mode (the rename delay plus unarchive and re-archive edits). Pending
+	// renames are reserved out of the budget before each lookup.
+	migrateRenameCost = 3 * time.Second
+	// migrateLookupDelay spaces out IGDB lookups to stay under its rate limit
+	migrateLookupDelay = 300 * time.Millisecond
+	// migrateRenameDelay is the delay between thread renames in execute mode
+	migrateRenameDelay = time.Second
+	// migrateMinPrefixLen is the shortest thread name a suggestion may extend
+	migrateMinPrefixLen = 4
+	// migrateMaxListed is how many changes are listed in the response embed
+	migrateMaxListed = 15
+)
+
+// Migration row statuses, as written to the CSV.
+const (
+	migrateStatusProposed  = "proposed"
+	migrateStatusRenamed   = "renamed"
+	migrateStatusFailed    = "failed"
+	migrateStatusDuplicate = "skipped_duplicate"
+)
+
+// canonicalGameName picks the IGDB title a legacy thread should be renamed to.
+// An exact match always wins. Otherwise the first suggestion is accepted only
+// when it is the thread name with punctuation/case fixed, or when it extends a
+// reasonably long thread name ("elden" -> "Elden Ring"). It returns "" when
+// nothing is confident enough to rename to.
+func canonicalGameName(threadName string, res *games.GameSearchResult) (name string, gameID int, match string) {
+	if res == nil {
+		return "", 0, ""
+	}
+	if res.ExactMatch != nil && res.ExactMatch.Name != "" {
+		return res.ExactMatch.Name, res.ExactMatch.ID, "exact"
+	}
+	thread := compactName(threadName)
+	if thread == "" {
+		return "", 0, ""
+	}
+	for _, g := range res.Suggestions {
+		if g == nil || g.Name == "" {
+			continue
+		}
+		candidate := compactName(g.Name)
+		if candidate == thread || (len(thread) >= migrateMinPrefixLen && strings.HasPrefix(candidate, thread)) {
+			return g.Name, g.ID, "suggestion"
+		}
+		// Only the top suggestion is trusted; later ones are too loose.
+		break
+	}
+	return "", 0, ""
+}
+
+// migrationRow is one proposed or applied thread rename.
+type migrationRow struct {
+	ThreadID string
+	OldName  string
+	NewName  string
+	GameID   int
+	Match    string
+	Status   string
+	Err      error
+	Archived bool // the thread has to be unarchived to be renamed
+}
+
+// migrateInput contains all inputs for the testable migrate run
+type migrateInput struct {
+	Threads []*forumcache.ThreadMeta
+	Limit   int
+	Execute bool
+	// After skips threads up to and including this thread ID, so a run can
+	// pick up where the previous one stopped. Empty starts from the oldest.
+	After string
+	// BotUserID is the bot's user ID. Threads it owns were created from an
+	// IGDB match and already have canonical names, so they are skipped
+	// without a lookup.
+	BotUserID string
+	Lookup    func(name string) (*games.GameSearchResult, error)
+	// Taken reports whether another thread already uses name.
+	Taken func(name, threadID string) bool
+	// Rename renames a thread. archived threads must be unarchived for the
+	// edit and archived again afterwards.
+	Rename func(threadID, name string, archived bool) error
+	Sleep  func(time.Duration)
+	// Deadline, when set, stops lookups early so the run (including pending
+	// renames) finishes before it. Now reads the clock.
+	Deadline time.Time
+	Now      func() time.Time
+}
+
+// migrateResult summarizes a migrate run
+type migrateResult struct {
+	Rows         []migrationRow
+	Scanned      int
+	Unmatched    int
+	LookupErrors int
+	BotCreated   int    // threads skipped because the bot created them
+	Remaining    int    // threads left for a later run because of Limit or Deadline
+	LastScanned  string // ID of the last thread looked up; the next run's After
+	OutOfTime    bool   // lookups stopped early because of Deadline
+}
+
+// runMigration looks up up to Limit threads after After, oldest first, and
+// proposes a rename for each one whose name isn't already the canonical IGDB
+// title. Bot-created threads are dropped before the limit is applied, so they
+// don't use up lookups. Lookups stop early when the deadline would leave too
+// little time for the renames found so far. In execute mode the renames are
+// applied one at a time.
+func runMigration(in migrateInput) migrateResult {
+	var res migrateResult
+	after, _ := strconv.ParseUint(in.After, 10, 64)
+	threads := make([]*forumcache.ThreadMeta, 0, len(in.Threads))
+	for _, t := range in.Threads {
+		if after > 0 {
+			// Thread IDs are snowflakes, so they order by creation time.
+			if id, err := strconv.ParseUint(t.ID, 10, 64); err == nil && id <= after {
+				continue
+			}
+		}
+		if in.BotUserID != "" && t.OwnerID == in.BotUserID {
+			res.BotCreated++
+			continue
+		}
+		threads = append(threads, t)
+	}
+	sort.Slice(threads, func(a, b int) bool {
+		if !threads[a].CreatedAt.Equal(threads[b].CreatedAt) {
+			return threads[a].CreatedAt.Before(threads[b].CreatedAt)
+		}
+		return threads[a].ID < threads[b].ID
+	})
+
+	if len(threads) > in.Limit {
+		res.Remaining = len(threads) - in.Limit
+		threads = threads[:in.Limit]
+	}
+
+	pending := 0 // proposed renames, each reserved out of the deadline
+	for idx, t := range threads {
+		if !in.Deadline.IsZero() {
+			reserve := migrateLookupDelay
+			if in.Execute {
+				reserve += time.Duration(pending) * migrateRenameCost
+			}
+			if !in.Now().Add(reserve).Before(in.Deadline) {
+				res.Remaining += len(threads) - idx
+				res.OutOfTime = true
+				break
+			}
+		}
+		if idx > 0 {
+			in.Sleep(migrateLookupDelay)
+		}
+		res.Scanned++
+		res.LastScanned = t.ID
+		lookup, err := in.Lookup(t.Name)
+		if err != nil {
+			res.LookupErrors++
+			continue
+		}
+		name, gameID, match := canonicalGameName(t.Name, lookup)
+		if name == "" {
+			res.Unmatched++
+			continue
+		}
+		if name == t.Name {
+			continue
+		}
+		row := migrationRow{ThreadID: t.ID, OldName: t.Name, NewName: name, GameID: gameID, Match: match, Status: migrateStatusProposed, Archived: t.Archived}
+		if in.Taken(name, t.ID) {
+			row.Status = migrateStatusDuplicate
+		} else {
+			pending++
+		}
+		res.Rows = append(res.Rows, row)
+	}
+
+	if !in.Execute {
+		return res
+	}
+	renamed := 0
+	for idx := range res.Rows {
+		row := &res.Rows[idx]
+		if row.Status != migrateStatusProposed {
+			continue
+		}
+		if renamed > 0 {
+			in.Sleep(migrateRenameDelay)
+		}
+		renamed++
+		if err := in.Rename(row.ThreadID, row.NewName, row.Archived); err != nil {
+			row.Status = migrateStatusFailed
+			row.Err = err
+			continue
+		}
+		row.Status = migrateStatusRenamed
+	}
+	return res
+}
+
+// buildMigrationCSV exports migration rows to CSV.
+// Columns: thread_id, old_name, new_name, igdb_id, match, status, error, url
+func buildMigrationCSV(rows []migrationRow, guildID string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"thread_id", "old_name", "new_name", "igdb_id", "match", "status", "error", "url"}); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		errStr := ""
+		if r.Err != nil {
+			errStr = r.Err.Error()
+		}
+		url := fmt.Sprintf("https://discord.com/channels/%s/%s", guildID, r.ThreadID)
+		if err := w.Write([]string{r.ThreadID, r.OldName, r.NewName, strconv.Itoa(r.GameID), r.Match, r.Status, errStr, url}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// handleLFGMigrate proposes (or applies) canonical IGDB names for legacy LFG
+// threads created before the bot managed the forum.
+func (m *Module) handleLFGMigrate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	gcfg := m.config.PrimaryGuild()
+	guildID := gcfg.GuildID()
+	forumID := gcfg.GetGamerPalsLFGForumChannelID()
+	if forumID == "" || guildID == "" {
+		_ = s.InteractionRespond(i.Interaction,
+			&discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Content: "❌ Missing guild or LFG forum config.", Flags: discordgo.MessageFlagsEphemeral},
+			},
+		)
+		return
+	}
+
+	execute := false
+	limit := defaultMigrateLimit
+	var after string
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "execute":
+			execute = opt.BoolValue()
+		case "limit":
+			limit = min(int(opt.IntValue()), maxMigrateLimit)
+		case "after":
+			after = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(opt.StringValue()), "<#"), ">")
+		}
+	}
+	if _, err := strconv.ParseUint(after, 10, 64); after != "" && err != nil {
+		_ = s.InteractionRespond(i.Interaction,
+			&discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Content: "❌ `after` must be a thread ID from a previous run.", Flags: discordgo.MessageFlagsEphemeral},
+			},
+		)
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}})
+
+	threads, ok := m.forumCache.ListThreads(forumID)
+	if !ok {
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: new("❌ LFG forum isn't cached yet. Try `/lfg-admin refresh-thread-cache` first.")}, true)
+		return
+	}
+
+	var botUserID string
+	if s.State != nil && s.State.User != nil {
+		botUserID = s.State.User.ID
+	}
+	start := time.Now()
+	res := runMigration(migrateInput{
+		Threads:   threads,
+		Limit:     limit,
+		Execute:   execute,
+		After:     after,
+		BotUserID: botUserID,
+		Lookup: func(name string) (*games.GameSearchResult, error) {
+			return games.ExactMatchWithSuggestions(m.igdbClient, name)
+		},
+		Taken: func(name, threadID string) bool {
+			meta, found := m.forumCache.GetThreadByExactName(forumID, name)
+			return found && meta.ID != threadID
+		},
+		Rename: func(threadID, name string, archived bool) error {
+			// Discord rejects edits to archived threads, so unarchive in the
+			// same edit and archive again once renamed.
+			edit := &discordgo.ChannelEdit{Name: name}
+			if archived {
+				edit.Archived = new(false)
+			}
+			if _, err := s.ChannelEdit(threadID, edit); err != nil {
+				m.config.Logger.Warnf("LFG migrate: failed to rename thread %s to %q: %v", threadID, name, err)
+				return err
+			}
+			m.config.Logger.Infof("LFG migrate: renamed thread %s to %q", threadID, name)
+			if archived {
+				if _, err := s.ChannelEdit(threadID, &discordgo.ChannelEdit{Archived: new(true)}); err != nil {
+					m.config.Logger.Warnf("LFG migrate: renamed thread %s but failed to archive it again: %v", threadID, err)
+				}
+			}
+			return nil
+		},
+		Sleep:    time.Sleep,
+		Deadline: start.Add(migrateBudget),
+		Now:      time.Now,
+	})
+
+	counts := make(map[string]int)
+	for _, r := range res.Rows {
+		counts[r.Status]++
+	}
+
+	mode := "Dry Run"
+	color := utils.Colors.Info()
+	if execute {
+		mode = "Executed"
+		color = utils.Colors.Warning()
+	}
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("LFG Thread Migration (%s)", mode),
+		Color: color,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Scanned", Value: strconv.Itoa(res.Scanned), Inline: true},
+			{Name: "Renames", Value: strconv.Itoa(counts[migrateStatusProposed] + counts[migrateStatusRenamed]), Inline: true},
+			{Name: "Skipped (duplicate)", Value: strconv.Itoa(counts[migrateStatusDuplicate]), Inline: true},
+			{Name: "No confident match", Value: strconv.Itoa(res.Unmatched), Inline: true},
+			{Name: "Lookup errors", Value: strconv.Itoa(res.LookupErrors), Inline: true},
+			{Name: "Skipped (bot-created)", Value: strconv.Itoa(res.BotCreated), Inline: true},
+		},
+	}
+	if execute {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Rename failures", Value: strconv.Itoa(counts[migrateStatusFailed]), Inline: true})
+	}
+	if res.Remaining > 0 {
+		text := fmt.Sprintf("%d more threads not scanned; run again with after:%s to continue.", res.Remaining, res.LastScanned)
+		if res.OutOfTime {
+			text = "Stopped early to answer within Discord's 15 minute limit. " + text
+		}
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: text}
+	}
+
+	var lines []string
+	for _, r := range res.Rows {
+		if len(lines) >= migrateMaxListed {
+			lines = append(lines, fmt.Sprintf("…and %d more (see CSV)", len(res.Rows)-migrateMaxListed))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("<#%s>: %q → %q (%s)", r.ThreadID, r.OldName, r.NewName, r.Status))
+	}
+	if len(lines) == 0 {
+		embed.Description = "No threads need renaming."
+	} else {
+		embed.Description = strings.Join(lines, "\n")
+	}
+
+	var files []*discordgo.File
+	var csvBytes []byte
+	if len(res.Rows) > 0 {
+		var err error
+		csvBytes, err = buildMigrationCSV(res.Rows, guildID)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to build LFG migrate CSV: %v", err)
+		} else {
+			files = append(files, &discordgo.File{Name: "lfg_migrate.csv", ContentType: "text/csv", Reader: bytes.NewReader(csvBytes)})
+		}
+	}
+
+	if err := utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Embeds: &[]*discordgo.MessageEmbed{embed}, Files: files}, true); err != nil {
+		m.config.Logger.Errorf("Error sending LFG migrate response: %v", err)
+	}
+
+	if execute && counts[migrateStatusRenamed] > 0 && i.Member != nil {
+		logMsg := fmt.Sprintf("%s renamed %d legacy LFG threads to their IGDB titles (%d failed). Full list attached.",
+			i.Member.User.Mention(), counts[migrateStatusRenamed], counts[migrateStatusFailed])
+		if err := utils.LogToChannelWithEmbedAndFile(m.config, s, logMsg, "lfg_migrate.csv", bytes.NewReader(csvBytes)); err != nil {
+			m.config.Logger.Warnf("Failed to log LFG migrate: %v", err)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package lfg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/games"
+
+	"github.com/Henry-Sarabia/igdb/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalGameName(t *testing.T) {
+	tests := []struct {
+		name      string
+		thread    string
+		res       *games.GameSearchResult
+		wantName  string
+		wantMatch string
+	}{
+		{
+			name:      "exact match wins",
+			thread:    "apex legends",
+			res:       &games.GameSearchResult{ExactMatch: &igdb.Game{ID: 1, Name: "Apex Legends"}},
+			wantName:  "Apex Legends",
+			wantMatch: "exact",
+		},
+		{
+			name:      "suggestion with punctuation fixed",
+			thread:    "repo",
+			res:       &games.GameSearchResult{Suggestions: []*igdb.Game{{ID: 2, Name: "R.E.P.O."}}},
+			wantName:  "R.E.P.O.",
+			wantMatch: "suggestion",
+		},
+		{
+			name:      "suggestion extending a long prefix",
+			thread:    "Elden",
+			res:       &games.GameSearchResult{Suggestions: []*igdb.Game{{ID: 3, Name: "Elden Ring"}}},
+			wantName:  "Elden Ring",
+			wantMatch: "suggestion",
+		},
+		{
+			name:   "short prefix is not trusted",
+			thread: "cod",
+			res:    &games.GameSearchResult{Suggestions: []*igdb.Game{{ID: 4, Name: "Cod Fishing Simulator"}}},
+		},
+		{
+			name:   "only the top suggestion is considered",
+			thread: "minecraft",
+			res:    &games.GameSearchResult{Suggestions: []*igdb.Game{{ID: 5, Name: "Terraria"}, {ID: 6, Name: "Minecraft"}}},
+		},
+		{
+			name:   "no results",
+			thread: "general chat",
+			res:    &games.GameSearchResult{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, _, match := canonicalGameName(tt.thread, tt.res)
+			require.Equal(t, tt.wantName, name)
+			require.Equal(t, tt.wantMatch, match)
+		})
+	}
+}
+
+func TestRunMigration(t *testing.T) {
+	base := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	threads := []*forumcache.ThreadMeta{
+		{ID: "3", Name: "elden", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "1", Name: "apex legends", CreatedAt: base, Archived: true},
+		{ID: "2", Name: "Minecraft", CreatedAt: base.Add(time.Hour)},
+		{ID: "4", Name: "broken", CreatedAt: base.Add(3 * time.Hour)},
+		{ID: "5", Name: "overwatch", CreatedAt: base.Add(4 * time.Hour)},
+		{ID: "0", Name: "Terraria", OwnerID: "bot", CreatedAt: base.Add(-time.Hour)},
+	}
+	lookup := func(name string) (*games.GameSearchResult, error) {
+		switch name {
+		case "apex legends":
+			return &games.GameSearchResult{ExactMatch: &igdb.Game{ID: 10, Name: "Apex Legends"}}, nil
+		case "Minecraft":
+			return &games.GameSearchResult{ExactMatch: &igdb.Game{ID: 11, Name: "Minecraft"}}, nil
+		case "elden":
+			return &games.GameSearchResult{Suggestions: []*igdb.Game{{ID: 12, Name: "Elden Ring"}}}, nil
+		case "overwatch":
+			return &games.GameSearchResult{ExactMatch: &igdb.Game{ID: 13, Name: "Overwatch"}}, nil
+		}
+		return nil, errors.New("igdb down")
+	}
+	taken := func(name, threadID string) bool { return name == "Overwatch" }
+
+	run := func(execute bool, limit int, after, failRename string) (migrateResult, []string, int) {
+		var renamed []string
+		sleeps := 0
+		res := runMigration(migrateInput{
+			Threads:   threads,
+			Limit:     limit,
+			Execute:   execute,
+			After:     after,
+			BotUserID: "bot",
+			Lookup:    lookup,
+			Taken:     taken,
+			Rename: func(threadID, name string, archived bool) error {
+				if threadID == failRename {
+					return errors.New("missing permissions")
+				}
+				entry := threadID + "=" + name
+				if archived {
+					entry += " (archived)"
+				}
+				renamed = append(renamed, entry)
+				return nil
+			},
+			Sleep: func(time.Duration) { sleeps++ },
+		})
+		return res, renamed, sleeps
+	}
+
+	t.Run("dry run proposes without renaming", func(t *testing.T) {
+		res, renamed, _ := run(false, 50, "", "")
+		require.Empty(t, renamed)
+		require.Equal(t, 5, res.Scanned)
+		require.Equal(t, 1, res.BotCreated)
+		require.Equal(t, 1, res.LookupErrors)
+		require.Len(t, res.Rows, 3)
+		require.Equal(t, "1", res.Rows[0].ThreadID, "oldest thread first")
+		require.Equal(t, migrateStatusProposed, res.Rows[0].Status)
+		require.Equal(t, "Elden Ring", res.Rows[1].NewName)
+		require.Equal(t, migrateStatusDuplicate, res.Rows[2].Status)
+	})
+
+	t.Run("execute renames proposed rows only", func(t *testing.T) {
+		res, renamed, sleeps := run(true, 50, "", "3")
+		require.Equal(t, []string{"1=Apex Legends (archived)"}, renamed)
+		require.Equal(t, migrateStatusRenamed, res.Rows[0].Status)
+		require.Equal(t, migrateStatusFailed, res.Rows[1].Status)
+		require.Error(t, res.Rows[1].Err)
+		require.Equal(t, migrateStatusDuplicate, res.Rows[2].Status)
+		require.Equal(t, 4+1, sleeps, "lookup delays plus one rename delay")
+	})
+
+	t.Run("limit scans the oldest threads", func(t *testing.T) {
+		res, _, _ := run(false, 2, "", "")
+		require.Equal(t, 2, res.Scanned)
+		require.Equal(t, 3, res.Remaining, "bot-created thread doesn't count against the limit")
+		require.Equal(t, "2", res.LastScanned)
+		require.Len(t, res.Rows, 1)
+	})
+
+	t.Run("after continues from the previous run", func(t *testing.T) {
+		res, _, _ := run(false, 2, "2", "")
+		require.Equal(t, 2, res.Scanned)
+		require.Equal(t, 1, res.Remaining)
+		require.Equal(t, "4", res.LastScanned)
+		require.Len(t, res.Rows, 1)
+		require.Equal(t, "3", res.Rows[0].ThreadID)
+
+		res, _, _ = run(false, 2, "4", "")
+		require.Equal(t, 1, res.Scanned)
+		require.Zero(t, res.Remaining)
+		require.Equal(t, migrateStatusDuplicate, res.Rows[0].Status)
+	})
+
+	t.Run("deadline stops lookups early", func(t *testing.T) {
+		for _, tt := range []struct {
+			name        string
+			execute     bool
+			wantScanned int
+		}{
+			// Each lookup waits migrateLookupDelay, so a deadline of 10
+			// delays leaves room for every lookup in a dry run.
+			{name: "dry run", wantScanned: 5},
+			// Executing reserves migrateRenameCost for the pending rename of
+			// thread 1, which uses up the rest of the budget.
+			{name: "execute reserves rename time", execute: true, wantScanned: 1},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				now := base
+				res := runMigration(migrateInput{
+					Threads:   threads,
+					Limit:     50,
+					Execute:   tt.execute,
+					BotUserID: "bot",
+					Lookup:    lookup,
+					Taken:     taken,
+					Rename:    func(string, string, bool) error { return nil },
+					Sleep:     func(d time.Duration) { now = now.Add(d) },
+					Deadline:  base.Add(10 * migrateLookupDelay),
+					Now:       func() time.Time { return now },
+				})
+				require.Equal(t, tt.wantScanned, res.Scanned)
+				require.Equal(t, 5-tt.wantScanned, res.Remaining)
+				require.Equal(t, tt.wantScanned < 5, res.OutOfTime)
+			})
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		res, _, _ := run(true, 50, "", "3")
+		out, err := buildMigrationCSV(res.Rows, "g")
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		require.Equal(t, "thread_id,old_name,new_name,igdb_id,match,status,error,url", lines[0])
+		require.Equal(t, "1,apex legends,Apex Legends,10,exact,renamed,,https://discord.com/channels/g/1", lines[1])
+		require.Equal(t, "3,elden,Elden Ring,12,suggestion,failed,missing permissions,https://discord.com/channels/g/3", lines[2])
+	})
+}
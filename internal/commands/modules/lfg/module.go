@@ -4,7 +4,7 @@ import (
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
 	"gamerpal/internal/forumcache"
-	"sync"
+	"gamerpal/internal/utils/cache"
 	"time"
 
 	"github.com/Henry-Sarabia/igdb/v2"
@@ -18,16 +18,47 @@ type pendingLFGNow struct {
 	Message        string
 	PlayerCount    int
 	VoiceChannelID string
-	UserID         string
-	ExpiresAt      time.Time
+	// CreateVoice asks for a temporary voice channel, created only once the
+	// game choice is made so abandoned prompts don't leave channels behind.
+	CreateVoice bool
+	UserID      string
 }
 
+const (
+	// pendingNowMaxEntries bounds abandoned /lfg now prompts held in memory.
+	pendingNowMaxEntries = 1000
+	// lastPingMaxEntries bounds the ping cooldown table; the oldest entries
+	// are long past any cooldown by the time they are evicted.
+	lastPingMaxEntries = 5000
+	// threadChannelCacheSize and threadChannelCacheTTL keep recently resolved
+	// thread channels around briefly. The forum cache stays the source of
+	// truth for which threads exist; this only saves the per-hit API call.
+	threadChannelCacheSize = 500
+	threadChannelCacheTTL  = 2 * time.Minute
+	// coverCacheSize bounds IGDB cover URL lookups; cover IDs are immutable.
+	coverCacheSize = 1000
+)
+
 // Module implements the CommandModule interface for LFG commands
 type Module struct {
 	config     *config.Config
 	igdbClient *igdb.Client
 	forumCache *forumcache.Service
-	pendingNow sync.Map
+	// pendingNow holds /lfg now options awaiting a button click, keyed by
+	// the short ID embedded in the button custom ID.
+	pendingNow *cache.Cache[string, pendingLFGNow]
+	// lastPing tracks the last in-thread /lfg now ping per user+thread
+	// ("userID:threadID" → time.Time) for the ping cooldown.
+	lastPing *cache.Cache[string, time.Time]
+	// threadChannels memoizes channel lookups for threads resolved through
+	// the forum cache so repeated searches don't hit the API every time.
+	threadChannels *cache.Cache[string, *discordgo.Channel]
+	// coverURLs maps IGDB cover IDs to their image URL.
+	coverURLs *cache.Cache[int, string]
+	// threadCreates is the per-user sliding window of recent thread creations.
+	threadCreates creationLimiter
+	// igdbAlerts throttles IGDB outage alerts to the log channel.
+	igdbAlerts outageAlerter
 	service    *LfgService
 	// session is captured so agent tools (see agent_tools.go) can dispatch
 	// to session-taking helpers from inside tool handler closures. May be
@@ -38,11 +69,15 @@ type Module struct {
 // New creates a new LFG module
 func New(deps *types.Dependencies) *Module {
 	return &Module{
-		config:     deps.Config,
-		igdbClient: deps.IGDBClient,
-		forumCache: deps.ForumCache,
-		service:    NewLfgService(deps.Config),
-		session:    deps.Session,
+		config:         deps.Config,
+		igdbClient:     deps.IGDBClient,
+		forumCache:     deps.ForumCache,
+		pendingNow:     cache.New[string, pendingLFGNow](pendingNowMaxEntries, pendingNowTTL),
+		lastPing:       cache.New[string, time.Time](lastPingMaxEntries, 0),
+		threadChannels: cache.New[string, *discordgo.Channel](threadChannelCacheSize, threadChannelCacheTTL),
+		coverURLs:      cache.New[int, string](coverCacheSize, 0),
+		service:        NewLfgService(deps.Config, deps.DB),
+		session:        deps.Session,
 	}
 }
 
@@ -101,6 +136,12 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 								discordgo.ChannelTypeGuildStageVoice,
 							},
 						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "create_voice",
+							Description: "Create a temporary voice channel for your group (ignored if voice_channel is set)",
+							Required:    false,
+						},
 					},
 				},
 			},
@@ -127,18 +168,103 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "refresh-thread-cache",
-					Description: "Rebuild all registered forum caches (LFG + Introductions)",
+					Description: "Rebuild the LFG (including extra LFG forums) and Introductions forum caches",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "clear-thread-cache",
+					Description: "Wipe one forum's cache and rebuild it from scratch",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "forum",
+							Description:  "Cached forum to clear",
+							Required:     true,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildForum},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "reconcile",
+					Description: "Diff forum caches against Discord and fix any drift (LFG + Introductions)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "forum",
+							Description:  "Only reconcile this cached forum",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildForum},
+						},
+					},
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "cache-stats",
 					Description: "Show forum cache stats (LFG + Introductions)",
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "trending",
+					Description: "Show the most active or newest LFG threads",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "window",
+							Description: "How far back to look (default 7 days)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "7 days", Value: 7},
+								{Name: "30 days", Value: 30},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "rank",
+							Description: "Rank by latest message or by creation time (default activity)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Activity", Value: trendingByActivity},
+								{Name: "Created", Value: trendingByCreated},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "migrate",
+					Description: "Rename legacy LFG threads to their IGDB game titles (dry run by default)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "execute",
+							Description: "Actually rename the threads (default: dry run)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "limit",
+							Description: "How many threads to look up, oldest first (default 50, max 200)",
+							Required:    false,
+							MinValue:    new(1.0),
+							MaxValue:    maxMigrateLimit,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "after",
+							Description: "Continue after this thread ID (shown in the previous run's footer)",
+							Required:    false,
+						},
+					},
+				},
 			},
 			DefaultMemberPermissions: &modPerms,
 			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
 		},
 		HandlerFunc: m.handleLFG,
+		SubcommandCooldowns: map[string]*types.Cooldown{
+			"refresh-thread-cache": {Window: (*config.GuildConfig).GetLFGRefreshCacheCooldown, Scope: types.CooldownPerGuild},
+		},
 	}
 
 	// Register game-thread command
@@ -164,6 +290,7 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 			},
 		},
 		HandlerFunc: m.handleGameThread,
+		Cooldown:    &types.Cooldown{Window: (*config.GuildConfig).GetLFGGameThreadCooldown, Scope: types.CooldownPerUser},
 	}
 }
 
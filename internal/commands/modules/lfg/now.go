@@ -3,6 +3,7 @@ package lfg
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"gamerpal/internal/config"
 	"gamerpal/internal/utils"
@@ -19,22 +20,13 @@ func (m *Module) storePendingNow(p pendingLFGNow) string {
 	b := make([]byte, 4)
 	_, _ = rand.Read(b)
 	key := hex.EncodeToString(b)
-	p.ExpiresAt = time.Now().Add(pendingNowTTL)
-	m.pendingNow.Store(key, p)
+	m.pendingNow.Set(key, p)
 	return key
 }
 
 // loadPendingNow retrieves and deletes pending options by key. Returns false if expired or missing.
 func (m *Module) loadPendingNow(key string) (pendingLFGNow, bool) {
-	val, ok := m.pendingNow.LoadAndDelete(key)
-	if !ok {
-		return pendingLFGNow{}, false
-	}
-	p := val.(pendingLFGNow)
-	if time.Now().After(p.ExpiresAt) {
-		return pendingLFGNow{}, false
-	}
-	return p, true
+	return m.pendingNow.Take(key)
 }
 
 // handleLFGNow handles /lfg now subcommand
@@ -42,9 +34,9 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 	// Defer an ephemeral reply
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}})
 
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
 	if forumID == "" {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ LFG forum channel ID not configured.")})
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(utils.T(i.Locale, utils.MsgLFGForumNotConfigured))})
 		return
 	}
 
@@ -52,6 +44,7 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 	var region, message string
 	var playerCount int
 	var voiceChannelID string
+	var createVoice bool
 	for _, o := range opts {
 		switch o.Name {
 		case "region":
@@ -62,18 +55,20 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 			playerCount = int(o.IntValue())
 		case "voice_channel":
 			voiceChannelID = o.ChannelValue(s).ID
+		case "create_voice":
+			createVoice = o.BoolValue()
 		}
 	}
 	message = strings.TrimSpace(message)
 	if message == "" {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ message required")})
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(utils.T(i.Locale, utils.MsgLFGNowMessageRequired))})
 		return
 	}
 	if len(message) > 140 {
 		message = message[:137] + "..."
 	}
 	if playerCount <= 0 || playerCount > 99 {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ invalid player_count")})
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(utils.T(i.Locale, utils.MsgLFGNowInvalidPlayerCount))})
 		return
 	}
 
@@ -83,7 +78,7 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 	if voiceChannelID != "" {
 		vc, err := s.Channel(voiceChannelID)
 		if err != nil || vc == nil || (vc.Type != discordgo.ChannelTypeGuildVoice && vc.Type != discordgo.ChannelTypeGuildStageVoice) {
-			_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ The provided voice_channel must be a voice or stage channel.")})
+			_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(utils.T(i.Locale, utils.MsgLFGNowInvalidVoiceChannel))})
 			return
 		}
 	}
@@ -92,11 +87,14 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 	inGameThread := err == nil && ch != nil && ch.ParentID == forumID
 
 	if !inGameThread {
+		// A temporary voice channel is created after the game choice, in
+		// handleLFGNowAnyGame.
 		key := m.storePendingNow(pendingLFGNow{
 			Region:         region,
 			Message:        message,
 			PlayerCount:    playerCount,
 			VoiceChannelID: voiceChannelID,
+			CreateVoice:    createVoice && voiceChannelID == "",
 			UserID:         userID,
 		})
 		components := []discordgo.MessageComponent{
@@ -106,33 +104,89 @@ func (m *Module) handleLFGNow(s *discordgo.Session, i *discordgo.InteractionCrea
 			}},
 		}
 		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-			Content:    new("Are you looking to play a **specific game** or **any game**?"),
+			Content:    new(utils.T(i.Locale, utils.MsgLFGNowSpecificOrAny)),
 			Components: &components,
 		})
 		return
 	}
 
+	// Create a temporary voice channel for the group if asked and none was given
+	if createVoice && voiceChannelID == "" {
+		vcID, errMsg := m.createNowVoice(i.GuildID, userID, fmt.Sprintf("LFG • %s", ch.Name), playerCount)
+		if errMsg != "" {
+			_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(errMsg)})
+			return
+		}
+		voiceChannelID = vcID
+	}
+
 	var voiceChannelMention string
 	if voiceChannelID != "" {
 		voiceChannelMention = fmt.Sprintf("Join voice: <#%s>\n", voiceChannelID)
 	}
 
-	// Public thread announcement with @here
-	publicContent := fmt.Sprintf("@here: <@%s> is looking to play!\n%s\n_%s_", userID, voiceChannelMention, message)
-	if _, err := s.ChannelMessageSend(ch.ID, publicContent); err != nil {
+	// Ping the configured LFG role (or @here), at most once per user per thread per cooldown
+	gcfg := m.config.ForGuild(i.GuildID)
+	mention := "@here"
+	if roleID := gcfg.GetLFGPingRoleID(); roleID != "" {
+		mention = fmt.Sprintf("<@&%s>", roleID)
+	}
+	pinged := m.canPing(userID, ch.ID, gcfg.GetLFGPingCooldown())
+	if !pinged {
+		mention = "🎮"
+	}
+
+	publicContent := fmt.Sprintf("%s: <@%s> is looking to play!\nRegion: **%s** • Looking for: **%d**\n%s\n_%s_", mention, userID, region, playerCount, voiceChannelMention, message)
+	sent, err := s.ChannelMessageSendComplex(ch.ID, &discordgo.MessageSend{Content: publicContent, Components: stillLookingComponents()})
+	if err != nil {
 		fallback := fmt.Sprintf("✅ Posted, but couldn't send public thread message.\n\n%s", publicContent)
 		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(fallback)})
 	} else {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("✅ Posted to Looking NOW feed.")})
+		if pinged {
+			m.recordPing(userID, ch.ID)
+		}
+		m.service.TrackNowPost(ch.ID, sent.ID, userID, gcfg.GetLFGNowPostExpiry())
+		confirm := "✅ Posted to Looking NOW feed."
+		if !pinged {
+			confirm += " You pinged this thread recently, so this post didn't ping anyone."
+		}
+		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(confirm)})
 	}
 
 	m.postToFeed(s, i.GuildID, userID, region, message, playerCount, voiceChannelID, ch)
 }
 
+// createNowVoice creates a temporary voice channel for an /lfg now group. On
+// failure it returns the message to show the user instead of a channel ID.
+func (m *Module) createNowVoice(guildID, userID, name string, playerCount int) (string, string) {
+	vc, err := m.service.CreateTempVoiceChannel(guildID, userID, name, playerCount)
+	if err != nil {
+		if errors.Is(err, errNoVoiceCategory) {
+			return "", "❌ Temporary voice channels aren't set up on this server. Pick an existing one with voice_channel."
+		}
+		m.config.Logger.Warnf("LFG: %v", err)
+		return "", "❌ Couldn't create a voice channel. Try again or pick an existing one with voice_channel."
+	}
+	return vc.ID, ""
+}
+
+// canPing reports whether the user may ping the given thread now. One ping
+// per user per thread per cooldown window; see recordPing.
+func (m *Module) canPing(userID, threadID string, cooldown time.Duration) bool {
+	prev, ok := m.lastPing.Get(userID + ":" + threadID)
+	return !ok || time.Since(prev) >= cooldown
+}
+
+// recordPing starts the user's ping cooldown for the thread. Call it only once
+// the ping was sent, so a failed send doesn't use up the window.
+func (m *Module) recordPing(userID, threadID string) {
+	m.lastPing.Set(userID+":"+threadID, time.Now())
+}
+
 // postToFeed sends the Looking NOW embed to the feed channel.
 // thread may be nil for "any game" posts.
 func (m *Module) postToFeed(s *discordgo.Session, guildID, userID, region, message string, playerCount int, voiceChannelID string, thread *discordgo.Channel) {
-	feedChannelID := m.config.GetLFGNowPanelChannelID()
+	feedChannelID := m.config.ForGuild(guildID).GetLFGNowPanelChannelID()
 	if feedChannelID == "" {
 		return
 	}
@@ -184,12 +238,41 @@ func (m *Module) postToFeed(s *discordgo.Session, guildID, userID, region, messa
 
 	// Send with role mention as message content (embeds don't trigger pings)
 	msgSend := &discordgo.MessageSend{
-		Embeds: []*discordgo.MessageEmbed{embed},
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: stillLookingComponents(),
 	}
-	if roleID := m.config.GetLFGNowRoleID(); roleID != "" {
+	if roleID := m.config.ForGuild(guildID).GetLFGNowRoleID(); roleID != "" {
 		msgSend.Content = fmt.Sprintf(":bell: <@&%s>", roleID)
 	}
-	_, _ = s.ChannelMessageSendComplex(feedChannelID, msgSend)
+	if sent, err := s.ChannelMessageSendComplex(feedChannelID, msgSend); err == nil {
+		m.service.TrackNowPost(feedChannelID, sent.ID, userID, m.config.ForGuild(guildID).GetLFGNowPostExpiry())
+	}
+}
+
+// stillLookingComponents is the "Still looking" button attached to Looking NOW posts.
+func stillLookingComponents() []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.Button{Style: discordgo.SecondaryButton, Label: "Still looking", Emoji: &discordgo.ComponentEmoji{Name: "⏳"}, CustomID: lfgNowStillLookingID},
+		}},
+	}
+}
+
+// handleLFGNowStillLooking refreshes the expiry of the presser's Looking NOW posts.
+func (m *Module) handleLFGNowStillLooking(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	userID := ""
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	}
+
+	content := "❌ Only the person who posted this can refresh it, and only before it expires."
+	if expiresAt, ok := m.service.ExtendNowPosts(i.Message.ID, userID, m.config.ForGuild(i.GuildID).GetLFGNowPostExpiry()); ok {
+		content = fmt.Sprintf("✅ Got it! Your Looking NOW post now expires <t:%d:R>.", expiresAt.Unix())
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	})
 }
 
 // handleLFGNowAnyGame handles the "Any game" button press from the /lfg now prompt.
@@ -203,16 +286,32 @@ func (m *Module) handleLFGNowAnyGame(s *discordgo.Session, i *discordgo.Interact
 	if !ok {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseUpdateMessage,
-			Data: &discordgo.InteractionResponseData{Content: "❌ This prompt has expired. Please run `/lfg now` again.", Components: []discordgo.MessageComponent{}},
+			Data: &discordgo.InteractionResponseData{Content: utils.T(i.Locale, utils.MsgLFGNowPromptExpired), Components: []discordgo.MessageComponent{}},
 		})
 		return
 	}
 
+	if pending.CreateVoice {
+		name := "LFG"
+		if i.Member != nil && i.Member.User != nil {
+			name = fmt.Sprintf("LFG • %s", i.Member.User.Username)
+		}
+		vcID, errMsg := m.createNowVoice(i.GuildID, pending.UserID, name, pending.PlayerCount)
+		if errMsg != "" {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseUpdateMessage,
+				Data: &discordgo.InteractionResponseData{Content: errMsg, Components: []discordgo.MessageComponent{}},
+			})
+			return
+		}
+		pending.VoiceChannelID = vcID
+	}
+
 	m.postToFeed(s, i.GuildID, pending.UserID, pending.Region, pending.Message, pending.PlayerCount, pending.VoiceChannelID, nil)
 
 	// Assign the LFG Now role if configured
 	confirmMsg := "✅ Posted to Looking NOW feed."
-	if roleID := m.config.GetLFGNowRoleID(); roleID != "" {
+	if roleID := m.config.ForGuild(i.GuildID).GetLFGNowRoleID(); roleID != "" {
 		expiresAt := m.service.AssignLFGNowRole(i.GuildID, pending.UserID)
 		if !expiresAt.IsZero() {
 			confirmMsg = fmt.Sprintf("✅ Posted to Looking NOW feed.\nYou've also been given the <@&%s> role (expires <t:%d:R>).", roleID, expiresAt.Unix())
@@ -227,7 +326,7 @@ func (m *Module) handleLFGNowAnyGame(s *discordgo.Session, i *discordgo.Interact
 
 // handleLFGNowSpecificGame handles the "Specific game" button press from the /lfg now prompt.
 func (m *Module) handleLFGNowSpecificGame(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	forumID := m.config.GetGamerPalsLFGForumChannelID()
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
 	forumURL := fmt.Sprintf("https://discord.com/channels/%s/%s", i.GuildID, forumID)
 	msg := fmt.Sprintf("Please run `/lfg now` in a [game thread](%s).", forumURL)
 
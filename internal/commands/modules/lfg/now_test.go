@@ -0,0 +1,24 @@
+package lfg
+
+import (
+	"testing"
+	"time"
+
+	"gamerpal/internal/utils/cache"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingCooldown(t *testing.T) {
+	m := &Module{lastPing: cache.New[string, time.Time](lastPingMaxEntries, 0)}
+
+	// Checking alone doesn't start the cooldown, so a failed send can retry.
+	require.True(t, m.canPing("u1", "t1", time.Hour))
+	require.True(t, m.canPing("u1", "t1", time.Hour))
+
+	m.recordPing("u1", "t1")
+	require.False(t, m.canPing("u1", "t1", time.Hour))
+	require.True(t, m.canPing("u1", "t2", time.Hour), "cooldown is per thread")
+	require.True(t, m.canPing("u2", "t1", time.Hour), "cooldown is per user")
+	require.True(t, m.canPing("u1", "t1", 0), "window elapsed")
+}
@@ -0,0 +1,80 @@
+package lfg
+
+import (
+	"sync"
+	"time"
+)
+
+// creationLimiter tracks recent thread creations per user over a sliding
+// window. The zero value is ready to use.
+type creationLimiter struct {
+	mu     sync.Mutex
+	recent map[string][]time.Time // userID → creation times, oldest first
+}
+
+// tryReserve checks the user's limit and, when they are under it, takes a
+// slot as of now in the same critical section, so two quick requests can't
+// both pass before either is counted. When refused, it returns when the
+// oldest creation ages out. Callers should release the slot if the creation
+// then fails.
+func (l *creationLimiter) tryReserve(userID string, limit int, window time.Duration, now time.Time) (bool, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	recent := l.prune(userID, window, now)
+	if len(recent) >= limit {
+		return false, recent[0].Add(window)
+	}
+	if l.recent == nil {
+		l.recent = make(map[string][]time.Time)
+	}
+	l.recent[userID] = append(recent, now)
+	return true, time.Time{}
+}
+
+// release gives back a slot taken by tryReserve at the given time.
+func (l *creationLimiter) release(userID string, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	times := l.recent[userID]
+	for idx := len(times) - 1; idx >= 0; idx-- {
+		if times[idx].Equal(at) {
+			l.recent[userID] = append(times[:idx], times[idx+1:]...)
+			break
+		}
+	}
+	if len(l.recent[userID]) == 0 {
+		delete(l.recent, userID)
+	}
+}
+
+// reserveThreadCreate applies the guild's thread creation limit to userID.
+// Moderators are exempt. On success it returns a func that releases the slot
+// if the creation fails; otherwise it returns when the user may try again.
+func (m *Module) reserveThreadCreate(guildID, userID string, isMod bool) (release func(), ok bool, retryAt time.Time) {
+	if isMod {
+		return func() {}, true, time.Time{}
+	}
+	gcfg := m.config.ForGuild(guildID)
+	now := time.Now()
+	if ok, retryAt := m.threadCreates.tryReserve(userID, gcfg.GetLFGThreadCreateLimit(), gcfg.GetLFGThreadCreateWindow(), now); !ok {
+		return nil, false, retryAt
+	}
+	return func() { m.threadCreates.release(userID, now) }, true, time.Time{}
+}
+
+// prune drops creations older than window and returns what remains. Callers
+// must hold l.mu.
+func (l *creationLimiter) prune(userID string, window time.Duration, now time.Time) []time.Time {
+	times := l.recent[userID]
+	keep := 0
+	for keep < len(times) && now.Sub(times[keep]) >= window {
+		keep++
+	}
+	times = times[keep:]
+	if len(times) == 0 {
+		delete(l.recent, userID)
+		return nil
+	}
+	l.recent[userID] = times
+	return times
+}
@@ -0,0 +1,109 @@
+package lfg
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"gamerpal/internal/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreationLimiterSlidingWindow(t *testing.T) {
+	base := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	window := 10 * time.Minute
+
+	tests := []struct {
+		name      string
+		prior     []time.Duration // earlier reservations, as offsets from base
+		at        time.Duration
+		wantOK    bool
+		wantRetry time.Duration // offset from base, checked when refused
+	}{
+		{name: "first creation", at: 0, wantOK: true},
+		{name: "under limit", prior: []time.Duration{0, time.Minute}, at: 2 * time.Minute, wantOK: true},
+		{name: "at limit", prior: []time.Duration{0, time.Minute, 2 * time.Minute}, at: 3 * time.Minute, wantRetry: window},
+		{name: "oldest just aged out", prior: []time.Duration{0, time.Minute, 2 * time.Minute}, at: window, wantOK: true},
+		{name: "retry when oldest ages out", prior: []time.Duration{-5 * time.Minute, time.Minute, 2 * time.Minute}, at: 4 * time.Minute, wantRetry: 5 * time.Minute},
+		{name: "refused attempts are not counted", prior: []time.Duration{0, time.Minute, 2 * time.Minute, 3 * time.Minute}, at: window, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l creationLimiter
+			for _, p := range tt.prior {
+				l.tryReserve("u1", 3, window, base.Add(p))
+			}
+			ok, retryAt := l.tryReserve("u1", 3, window, base.Add(tt.at))
+			require.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				require.Equal(t, base.Add(tt.wantRetry), retryAt)
+			}
+			// Other users have their own window.
+			ok, _ = l.tryReserve("u2", 3, window, base.Add(tt.at))
+			require.True(t, ok)
+		})
+	}
+}
+
+func TestCreationLimiterRelease(t *testing.T) {
+	var l creationLimiter
+	now := time.Now()
+	ok, _ := l.tryReserve("u1", 1, time.Minute, now)
+	require.True(t, ok)
+	ok, _ = l.tryReserve("u1", 1, time.Minute, now)
+	require.False(t, ok)
+
+	// A failed creation gives its slot back.
+	l.release("u1", now)
+	ok, _ = l.tryReserve("u1", 1, time.Minute, now)
+	require.True(t, ok)
+}
+
+func TestCreationLimiterConcurrentReserve(t *testing.T) {
+	var l creationLimiter
+	now := time.Now()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	granted := 0
+	for range 20 {
+		wg.Go(func() {
+			if ok, _ := l.tryReserve("u1", 3, time.Minute, now); ok {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	require.Equal(t, 3, granted)
+}
+
+func TestReserveThreadCreateModeratorExempt(t *testing.T) {
+	m := &Module{config: config.NewMockConfig(map[string]any{
+		"bot_token":                "x",
+		"lfg_thread_create_limit":  1,
+		"lfg_thread_create_window": "1h",
+	})}
+
+	tests := []struct {
+		name   string
+		isMod  bool
+		wantOK []bool
+	}{
+		{name: "member is limited", wantOK: []bool{true, false}},
+		{name: "moderator is exempt", isMod: true, wantOK: []bool{true, true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := tt.name
+			for idx, want := range tt.wantOK {
+				_, ok, retryAt := m.reserveThreadCreate("", userID, tt.isMod)
+				require.Equal(t, want, ok, "attempt %d", idx+1)
+				if !ok {
+					require.True(t, retryAt.After(time.Now()))
+				}
+			}
+		})
+	}
+}
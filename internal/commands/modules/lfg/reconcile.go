@@ -0,0 +1,213 @@
+package lfg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// reconcileMaxListed is how many changed threads each forum's embed field
+// lists; when any are left out the full diff is attached as CSV.
+const reconcileMaxListed = 10
+
+// Reconcile change kinds, as written to the CSV.
+const (
+	reconcileAdded   = "added"
+	reconcileRemoved = "removed"
+	reconcileUpdated = "updated"
+)
+
+// reconciledForum is one forum's reconcile outcome for the admin report.
+type reconciledForum struct {
+	Label  string
+	Result forumcache.ReconcileResult
+	Err    error
+}
+
+// reconcileChanges flattens a result into (kind, thread) pairs in the order
+// they are listed and exported.
+func reconcileChanges(res forumcache.ReconcileResult) (kinds []string, threads []*forumcache.ThreadMeta) {
+	for _, group := range []struct {
+		kind string
+		list []*forumcache.ThreadMeta
+	}{
+		{reconcileAdded, res.Added},
+		{reconcileRemoved, res.Removed},
+		{reconcileUpdated, res.Updated},
+	} {
+		for _, t := range group.list {
+			kinds = append(kinds, group.kind)
+			threads = append(threads, t)
+		}
+	}
+	return kinds, threads
+}
+
+// reconcileField renders one forum's outcome as an embed field: the summary
+// with timing, then as many changed threads as fit (at most
+// reconcileMaxListed). It reports whether any change was left out.
+func reconcileField(f reconciledForum) (*discordgo.MessageEmbedField, bool) {
+	// Discord doesn't render mentions in field names, so the forum goes in
+	// the value.
+	name := f.Label
+	forum := fmt.Sprintf("<#%s>\n", f.Result.ForumID)
+	if f.Err != nil {
+		return &discordgo.MessageEmbedField{Name: name, Value: forum + fmt.Sprintf("❌ Reconcile failed: %v", f.Err)}, false
+	}
+	value := forum + "`" + f.Result.String() + "`"
+	if f.Result.Initial {
+		return &discordgo.MessageEmbedField{Name: name, Value: value + "\nFirst full listing for this forum; nothing counted as drift."}, false
+	}
+	// Leave room under Discord's 1024 char field limit for the "more" line.
+	const maxFieldLen = 950
+	kinds, threads := reconcileChanges(f.Result)
+	for idx, t := range threads {
+		line := fmt.Sprintf("\n• %s <#%s> %q", kinds[idx], t.ID, t.Name)
+		if idx == reconcileMaxListed || len(value)+len(line) > maxFieldLen {
+			value += fmt.Sprintf("\n…and %d more (see CSV)", len(threads)-idx)
+			return &discordgo.MessageEmbedField{Name: name, Value: value}, true
+		}
+		value += line
+	}
+	return &discordgo.MessageEmbedField{Name: name, Value: value}, false
+}
+
+// buildReconcileCSV exports every change across forums.
+// Columns: forum_id, change, thread_id, name, owner_id, url
+func buildReconcileCSV(forums []reconciledForum, guildID string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"forum_id", "change", "thread_id", "name", "owner_id", "url"}); err != nil {
+		return nil, err
+	}
+	for _, f := range forums {
+		if f.Err != nil || f.Result.Initial {
+			continue
+		}
+		kinds, threads := reconcileChanges(f.Result)
+		for idx, t := range threads {
+			url := fmt.Sprintf("https://discord.com/channels/%s/%s", guildID, t.ID)
+			if err := w.Write([]string{f.Result.ForumID, kinds[idx], t.ID, t.Name, t.OwnerID, url}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// handleLFGReconcileCache diffs forum caches against a live listing and
+// reports exactly which threads were corrected. Without a forum option it
+// covers the LFG and intro forums; with one it covers just that forum.
+func (m *Module) handleLFGReconcileCache(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+
+	gcfg := m.config.PrimaryGuild()
+	guildID := gcfg.GuildID()
+	var picked string
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "forum" {
+			picked = opt.ChannelValue(nil).ID
+		}
+	}
+
+	var forums []struct{ label, id string }
+	if picked != "" {
+		if _, registered := m.forumCache.Stats(picked); !registered {
+			respond(fmt.Sprintf("❌ <#%s> isn't a cached forum.", picked))
+			return
+		}
+		forums = append(forums, struct{ label, id string }{"Forum", picked})
+	} else {
+		forums = append(forums,
+			struct{ label, id string }{"LFG", gcfg.GetGamerPalsLFGForumChannelID()},
+			struct{ label, id string }{"Intro", gcfg.GetGamerPalsIntroductionsForumChannelID()},
+		)
+	}
+	if guildID == "" || forums[0].id == "" {
+		respond("❌ Missing guild or LFG forum config.")
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}})
+
+	start := time.Now()
+	var results []reconciledForum
+	var logParts []string
+	failed, changes := false, 0
+	for _, f := range forums {
+		if f.id == "" {
+			continue
+		}
+		m.forumCache.RegisterForum(f.id)
+		res, err := m.forumCache.ReconcileForum(guildID, f.id)
+		res.ForumID = f.id
+		results = append(results, reconciledForum{Label: f.label, Result: res, Err: err})
+		if err != nil {
+			failed = true
+			m.config.Logger.Warnf("LFG: reconcile of %s forum failed: %v", f.label, err)
+			logParts = append(logParts, fmt.Sprintf("%s: failed reconcile", f.label))
+			continue
+		}
+		if !res.Initial {
+			changes += res.Corrections()
+		}
+		logParts = append(logParts, fmt.Sprintf("%s: %s", f.label, res))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:  "✅ Forum Cache Reconcile",
+		Color:  utils.Colors.Ok(),
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Total time %s", time.Since(start).Round(time.Millisecond))},
+	}
+	switch {
+	case failed:
+		embed.Title = "⚠️ Partial Forum Cache Reconcile"
+		embed.Color = utils.Colors.Warning()
+	case changes == 0:
+		embed.Description = "No drift found; the cache matched Discord."
+	default:
+		embed.Description = fmt.Sprintf("Corrected %d threads.", changes)
+	}
+	truncated := false
+	for _, r := range results {
+		field, cut := reconcileField(r)
+		embed.Fields = append(embed.Fields, field)
+		truncated = truncated || cut
+	}
+
+	var files []*discordgo.File
+	if truncated {
+		if csvBytes, err := buildReconcileCSV(results, guildID); err != nil {
+			m.config.Logger.Warnf("Failed to build reconcile CSV: %v", err)
+		} else {
+			files = append(files, &discordgo.File{Name: "forum_reconcile.csv", ContentType: "text/csv", Reader: bytes.NewReader(csvBytes)})
+		}
+	}
+
+	if err := utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Embeds: &[]*discordgo.MessageEmbed{embed}, Files: files}, true); err != nil {
+		m.config.Logger.Errorf("Error sending reconcile response: %v", err)
+	}
+
+	if i.Member != nil {
+		logMsg := fmt.Sprintf("%s triggered forum cache reconcile. %s", i.Member.User.Mention(), strings.Join(logParts, " | "))
+		if err := utils.LogToChannel(m.config, s, logMsg); err != nil {
+			m.config.Logger.Warnf("Failed to log forum cache reconcile: %v", err)
+		}
+	}
+}
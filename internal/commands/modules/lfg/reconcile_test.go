@@ -0,0 +1,71 @@
+package lfg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileField(t *testing.T) {
+	meta := func(id string) *forumcache.ThreadMeta {
+		return &forumcache.ThreadMeta{ID: id, Name: "game " + id, OwnerID: "o" + id}
+	}
+
+	t.Run("lists every change when small", func(t *testing.T) {
+		field, cut := reconcileField(reconciledForum{Label: "LFG", Result: forumcache.ReconcileResult{
+			ForumID: "f1",
+			Added:   []*forumcache.ThreadMeta{meta("1")},
+			Removed: []*forumcache.ThreadMeta{meta("2")},
+		}})
+		require.False(t, cut)
+		require.Equal(t, "LFG", field.Name)
+		require.True(t, strings.HasPrefix(field.Value, "<#f1>\n"))
+		require.Contains(t, field.Value, "• added <#1> \"game 1\"")
+		require.Contains(t, field.Value, "• removed <#2> \"game 2\"")
+	})
+
+	t.Run("caps the list and points at the CSV", func(t *testing.T) {
+		var added []*forumcache.ThreadMeta
+		for n := range reconcileMaxListed + 3 {
+			added = append(added, meta(fmt.Sprint(n)))
+		}
+		field, cut := reconcileField(reconciledForum{Label: "LFG", Result: forumcache.ReconcileResult{ForumID: "f1", Added: added}})
+		require.True(t, cut)
+		require.Equal(t, reconcileMaxListed, strings.Count(field.Value, "• added"))
+		require.Contains(t, field.Value, "…and 3 more (see CSV)")
+		require.LessOrEqual(t, len(field.Value), 1024)
+	})
+
+	t.Run("initial build lists nothing", func(t *testing.T) {
+		field, cut := reconcileField(reconciledForum{Label: "Intro", Result: forumcache.ReconcileResult{ForumID: "f2", Initial: true, Added: []*forumcache.ThreadMeta{meta("1")}}})
+		require.False(t, cut)
+		require.NotContains(t, field.Value, "• added")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		field, _ := reconcileField(reconciledForum{Label: "LFG", Result: forumcache.ReconcileResult{ForumID: "f1"}, Err: errors.New("403")})
+		require.Equal(t, "<#f1>\n❌ Reconcile failed: 403", field.Value)
+	})
+}
+
+func TestBuildReconcileCSV(t *testing.T) {
+	out, err := buildReconcileCSV([]reconciledForum{
+		{Label: "LFG", Result: forumcache.ReconcileResult{
+			ForumID: "f1",
+			Added:   []*forumcache.ThreadMeta{{ID: "1", Name: "Apex", OwnerID: "u1"}},
+			Updated: []*forumcache.ThreadMeta{{ID: "3", Name: "Doom, Eternal", OwnerID: "u3"}},
+		}},
+		{Label: "Intro", Err: errors.New("boom")},
+	}, "g")
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"forum_id,change,thread_id,name,owner_id,url",
+		"f1,added,1,Apex,u1,https://discord.com/channels/g/1",
+		`f1,updated,3,"Doom, Eternal",u3,https://discord.com/channels/g/3`,
+	}, "\n")+"\n", string(out))
+}
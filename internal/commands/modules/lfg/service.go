@@ -1,24 +1,49 @@
 package lfg
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
+	"gamerpal/internal/database"
 	"gamerpal/internal/utils"
 	"slices"
 	"sync"
 	"time"
+
+	"github.com/bwmarrin/discordgo"
 )
 
+// nowPost is a tracked Looking NOW message that gets marked as expired once
+// its owner stops refreshing it.
+type nowPost struct {
+	ChannelID string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// errNoVoiceCategory is returned when create_voice is used without a configured category.
+var errNoVoiceCategory = errors.New("lfg temp voice category not configured")
+
 // LfgService handles scheduled tasks for the LFG module.
 type LfgService struct {
 	types.BaseService
 	config    *config.Config
+	db        *database.DB
 	activeNow sync.Map // userID → time.Time (when role was assigned)
+	// activePosts maps messageID → nowPost. Entries are only replaced or
+	// removed with CompareAndSwap/CompareAndDelete, so an expired post can't
+	// be revived by a concurrent "Still looking" click.
+	activePosts sync.Map
+	// emptySince records when each temp voice channel was first seen empty.
+	// Only touched from the scheduled cleanup, which never overlaps itself.
+	emptySince map[string]time.Time
 }
 
 // NewLfgService creates a new LFG service.
-func NewLfgService(cfg *config.Config) *LfgService {
-	return &LfgService{config: cfg}
+func NewLfgService(cfg *config.Config, db *database.DB) *LfgService {
+	return &LfgService{config: cfg, db: db, emptySince: make(map[string]time.Time)}
 }
 
 // ScheduledFuncs returns scheduled tasks for the LFG module.
@@ -26,6 +51,8 @@ func (s *LfgService) ScheduledFuncs() map[string]func() error {
 	return map[string]func() error{
 		"@every 1m": func() error {
 			s.reconcileLFGNowRole()
+			s.expireNowPosts()
+			s.cleanupTempVoiceChannels()
 			return nil
 		},
 	}
@@ -34,13 +61,13 @@ func (s *LfgService) ScheduledFuncs() map[string]func() error {
 // AssignLFGNowRole gives the user the LFG Now role and tracks the assignment.
 // Returns the expiration time.
 func (s *LfgService) AssignLFGNowRole(guildID, userID string) time.Time {
-	roleID := s.config.GetLFGNowRoleID()
+	roleID := s.config.ForGuild(guildID).GetLFGNowRoleID()
 	if roleID == "" || s.Session == nil {
 		return time.Time{}
 	}
 
 	_ = s.Session.GuildMemberRoleAdd(guildID, userID, roleID)
-	expiresAt := time.Now().Add(s.config.GetLFGNowRoleDuration())
+	expiresAt := time.Now().Add(s.config.ForGuild(guildID).GetLFGNowRoleDuration())
 	s.activeNow.Store(userID, expiresAt)
 	return expiresAt
 }
@@ -51,8 +78,9 @@ func (s *LfgService) reconcileLFGNowRole() {
 	if s.Session == nil {
 		return
 	}
-	roleID := s.config.GetLFGNowRoleID()
-	guildID := s.config.GetGamerPalsServerID()
+	gcfg := s.config.PrimaryGuild()
+	guildID := gcfg.GuildID()
+	roleID := gcfg.GetLFGNowRoleID()
 	if roleID == "" || guildID == "" {
 		return
 	}
@@ -84,3 +112,199 @@ func (s *LfgService) reconcileLFGNowRole() {
 		}
 	}
 }
+
+// TrackNowPost records a Looking NOW message so it can be marked as expired
+// after ttl. Returns the expiration time.
+func (s *LfgService) TrackNowPost(channelID, messageID, userID string, ttl time.Duration) time.Time {
+	expiresAt := time.Now().Add(ttl)
+	s.activePosts.Store(messageID, nowPost{ChannelID: channelID, UserID: userID, ExpiresAt: expiresAt})
+	return expiresAt
+}
+
+// ExtendNowPosts pushes the expiry of all of the user's tracked posts out by
+// ttl from now. messageID is the post the request came from; returns false if
+// it isn't tracked (already expired) or belongs to someone else. Posts that
+// expire while this runs stay expired.
+func (s *LfgService) ExtendNowPosts(messageID, userID string, ttl time.Duration) (time.Time, bool) {
+	val, ok := s.activePosts.Load(messageID)
+	if !ok || val.(nowPost).UserID != userID {
+		return time.Time{}, false
+	}
+	expiresAt := time.Now().Add(ttl)
+	extended := false
+	s.activePosts.Range(func(key, value any) bool {
+		post := value.(nowPost)
+		if post.UserID != userID {
+			return true
+		}
+		post.ExpiresAt = expiresAt
+		if s.activePosts.CompareAndSwap(key, value, post) && key == messageID {
+			extended = true
+		}
+		return true
+	})
+	if !extended {
+		return time.Time{}, false
+	}
+	return expiresAt, true
+}
+
+// expireNowPosts marks Looking NOW posts past their expiry as no longer looking
+// and stops tracking them.
+func (s *LfgService) expireNowPosts() {
+	s.closeNowPosts(context.Background(), false)
+}
+
+// Shutdown closes out every open Looking NOW post. Tracking is in-memory only,
+// so posts left open across a restart would keep a dead "Still looking" button.
+func (s *LfgService) Shutdown(ctx context.Context) error {
+	s.closeNowPosts(ctx, true)
+	return ctx.Err()
+}
+
+// closeNowPosts marks tracked posts as no longer looking: only expired ones
+// unless all is set. It stops early once ctx is done.
+func (s *LfgService) closeNowPosts(ctx context.Context, all bool) {
+	if s.Session == nil {
+		return
+	}
+	now := time.Now()
+	s.activePosts.Range(func(key, value any) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		post := value.(nowPost)
+		if !all && now.Before(post.ExpiresAt) {
+			return true
+		}
+		// Skip posts extended or already closed since Range read them.
+		if !s.activePosts.CompareAndDelete(key, value) {
+			return true
+		}
+		if err := s.markNowPostExpired(post.ChannelID, key.(string)); err != nil {
+			s.config.Logger.Warnf("LFG: failed to expire Looking NOW post %s: %v", key, err)
+		}
+		return true
+	})
+}
+
+// markNowPostExpired strikes through a Looking NOW message (plain or embed) and
+// removes its "Still looking" button.
+func (s *LfgService) markNowPostExpired(channelID, messageID string) error {
+	msg, err := s.Session.ChannelMessage(channelID, messageID)
+	if err != nil {
+		return err
+	}
+
+	edit := discordgo.NewMessageEdit(channelID, messageID)
+	edit.Components = &[]discordgo.MessageComponent{}
+	if msg.Content != "" {
+		edit.SetContent(fmt.Sprintf("~~%s~~\n_No longer looking._", msg.Content))
+	}
+	if len(msg.Embeds) > 0 {
+		embed := *msg.Embeds[0]
+		embed.Title = "No longer looking"
+		embed.Color = utils.Colors.Warning()
+		edit.SetEmbeds(append([]*discordgo.MessageEmbed{&embed}, msg.Embeds[1:]...))
+	}
+	_, err = s.Session.ChannelMessageEditComplex(edit)
+	return err
+}
+
+// CreateTempVoiceChannel creates a temporary voice channel for an /lfg now
+// group under the configured category and persists it for later cleanup.
+func (s *LfgService) CreateTempVoiceChannel(guildID, ownerID, name string, userLimit int) (*discordgo.Channel, error) {
+	categoryID := s.config.ForGuild(guildID).GetLFGVoiceCategoryID()
+	if categoryID == "" {
+		return nil, errNoVoiceCategory
+	}
+	if s.Session == nil || s.db == nil {
+		return nil, fmt.Errorf("lfg service not initialized")
+	}
+	// Discord caps channel names at 100 characters; cut by rune so a
+	// non-ASCII thread name isn't split into invalid UTF-8.
+	if r := []rune(name); len(r) > 100 {
+		name = string(r[:100])
+	}
+
+	vc, err := s.Session.GuildChannelCreateComplex(guildID, discordgo.GuildChannelCreateData{
+		Name:      name,
+		Type:      discordgo.ChannelTypeGuildVoice,
+		ParentID:  categoryID,
+		UserLimit: min(max(userLimit, 2), 99),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp voice channel: %w", err)
+	}
+	if err := s.db.AddLFGTempVoiceChannel(vc.ID, guildID, ownerID); err != nil {
+		// Without a record nothing would clean it up later, so don't leak it.
+		_, _ = s.Session.ChannelDelete(vc.ID)
+		return nil, err
+	}
+	return vc, nil
+}
+
+// cleanupTempVoiceChannels deletes tracked temp voice channels that have been
+// empty for longer than the grace period. Tracking is persisted, so channels
+// created before a restart are reconciled on the first run afterwards.
+func (s *LfgService) cleanupTempVoiceChannels() {
+	if s.Session == nil || s.db == nil {
+		return
+	}
+	chans, err := s.db.ListLFGTempVoiceChannels()
+	if err != nil {
+		s.config.Logger.Warnf("LFG: failed to list temp voice channels: %v", err)
+		return
+	}
+
+	now := time.Now()
+	occupied := make(map[string]map[string]bool) // guildID → set of occupied channel IDs
+	for _, tc := range chans {
+		if _, err := s.Session.Channel(tc.ChannelID); err != nil {
+			var restErr *discordgo.RESTError
+			if errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == discordgo.ErrCodeUnknownChannel {
+				// Deleted out from under us; just stop tracking it.
+				s.forgetTempVoiceChannel(tc.ChannelID)
+			}
+			continue
+		}
+
+		if _, ok := occupied[tc.GuildID]; !ok {
+			guild, err := s.Session.State.Guild(tc.GuildID)
+			if err != nil {
+				continue // voice states unknown; try again next run
+			}
+			set := make(map[string]bool)
+			for _, vs := range guild.VoiceStates {
+				set[vs.ChannelID] = true
+			}
+			occupied[tc.GuildID] = set
+		}
+
+		if occupied[tc.GuildID][tc.ChannelID] {
+			delete(s.emptySince, tc.ChannelID)
+			continue
+		}
+		since, seen := s.emptySince[tc.ChannelID]
+		if !seen {
+			s.emptySince[tc.ChannelID] = now
+			continue
+		}
+		if now.Sub(since) < s.config.ForGuild(tc.GuildID).GetLFGVoiceEmptyGrace() {
+			continue
+		}
+		if _, err := s.Session.ChannelDelete(tc.ChannelID); err != nil {
+			s.config.Logger.Warnf("LFG: failed to delete empty temp voice channel %s: %v", tc.ChannelID, err)
+			continue
+		}
+		s.forgetTempVoiceChannel(tc.ChannelID)
+	}
+}
+
+// forgetTempVoiceChannel stops tracking a temp voice channel.
+func (s *LfgService) forgetTempVoiceChannel(channelID string) {
+	delete(s.emptySince, channelID)
+	if err := s.db.RemoveLFGTempVoiceChannel(channelID); err != nil {
+		s.config.Logger.Warnf("LFG: %v", err)
+	}
+}
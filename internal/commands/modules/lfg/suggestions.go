@@ -0,0 +1,95 @@
+package lfg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Henry-Sarabia/igdb/v2"
+)
+
+// suggestionsPerPage is how many numbered create buttons fit on one page.
+const suggestionsPerPage = 5
+
+// maxCustomIDLen is Discord's limit on a component custom ID.
+const maxCustomIDLen = 100
+
+// releaseYear returns the game's first release year, or 0 if unknown.
+func releaseYear(g *igdb.Game) int {
+	if g.FirstReleaseDate <= 0 {
+		return 0
+	}
+	return time.Unix(int64(g.FirstReleaseDate), 0).UTC().Year()
+}
+
+// dedupeSuggestions drops nameless games and repeats of the same title+year,
+// keeping at most limit games in their original order. Paging slices this
+// list, so a title never shows up on two pages.
+func dedupeSuggestions(gs []*igdb.Game, limit int) []*igdb.Game {
+	out := make([]*igdb.Game, 0, min(len(gs), limit))
+	seen := make(map[string]struct{})
+	for _, g := range gs {
+		if len(out) >= limit {
+			break
+		}
+		if g == nil || g.Name == "" {
+			continue
+		}
+		key := strings.ToLower(g.Name) + "::" + strconv.Itoa(releaseYear(g))
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, g)
+	}
+	return out
+}
+
+// suggestionPage returns the games on a zero-based page and whether a later
+// page exists.
+func suggestionPage(all []*igdb.Game, page int) ([]*igdb.Game, bool) {
+	start := page * suggestionsPerPage
+	if page < 0 || start >= len(all) {
+		return nil, false
+	}
+	end := min(start+suggestionsPerPage, len(all))
+	return all[start:end], end < len(all)
+}
+
+// suggestionsPageCustomID encodes a page and query for the "More options"
+// button, using the fuzzy prefix when the list came from the fuzzy fallback.
+// It returns "" if the result would exceed Discord's custom ID limit.
+func suggestionsPageCustomID(page int, query string, fuzzy bool) string {
+	prefix := lfgSuggestionsPagePrefix
+	if fuzzy {
+		prefix = lfgFuzzyPagePrefix
+	}
+	cid := fmt.Sprintf("%s::%d::%s", prefix, page, query)
+	if len(cid) > maxCustomIDLen {
+		return ""
+	}
+	return cid
+}
+
+// parseSuggestionsCustomID decodes the query and page from either the first
+// "Create a thread" button (always page 0) or a "More options" button, and
+// whether the list is fuzzy matches.
+func parseSuggestionsCustomID(cid string) (query string, page int, fuzzy bool, ok bool) {
+	if rest, found := strings.CutPrefix(cid, lfgMoreSuggestionsPrefix+"::"); found {
+		return rest, 0, false, rest != ""
+	}
+	rest, found := strings.CutPrefix(cid, lfgSuggestionsPagePrefix+"::")
+	if !found {
+		if rest, found = strings.CutPrefix(cid, lfgFuzzyPagePrefix+"::"); !found {
+			return "", 0, false, false
+		}
+		fuzzy = true
+	}
+	pageStr, query, found := strings.Cut(rest, "::")
+	page, err := strconv.Atoi(pageStr)
+	if !found || err != nil || page < 0 || query == "" {
+		return "", 0, false, false
+	}
+	return query, page, fuzzy, true
+}
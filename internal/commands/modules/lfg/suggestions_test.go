@@ -0,0 +1,104 @@
+package lfg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Henry-Sarabia/igdb/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func game(id int, name string, year int) *igdb.Game {
+	g := &igdb.Game{ID: id, Name: name}
+	if year > 0 {
+		g.FirstReleaseDate = int(time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC).Unix())
+	}
+	return g
+}
+
+func ids(gs []*igdb.Game) []int {
+	out := make([]int, 0, len(gs))
+	for _, g := range gs {
+		out = append(out, g.ID)
+	}
+	return out
+}
+
+func TestDedupeSuggestions(t *testing.T) {
+	in := []*igdb.Game{
+		game(1, "Doom", 1993),
+		nil,
+		game(2, "DOOM", 1993), // same title+year
+		game(3, "Doom", 2016), // same title, different year
+		{ID: 4},               // no name
+		game(5, "Doom II", 0),
+		game(6, "Doom 3", 2004),
+	}
+	require.Equal(t, []int{1, 3, 5, 6}, ids(dedupeSuggestions(in, 10)))
+	require.Equal(t, []int{1, 3}, ids(dedupeSuggestions(in, 2)))
+}
+
+func TestSuggestionPage(t *testing.T) {
+	var all []*igdb.Game
+	for n := range 12 {
+		all = append(all, game(n+1, fmt.Sprintf("Game %d", n+1), 0))
+	}
+
+	page, more := suggestionPage(all, 0)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids(page))
+	require.True(t, more)
+
+	page, more = suggestionPage(all, 2)
+	require.Equal(t, []int{11, 12}, ids(page))
+	require.False(t, more)
+
+	page, more = suggestionPage(all, 3)
+	require.Empty(t, page)
+	require.False(t, more)
+
+	page, _ = suggestionPage(all, -1)
+	require.Empty(t, page)
+}
+
+func TestSuggestionsCustomIDRoundTrip(t *testing.T) {
+	query, page, fuzzy, ok := parseSuggestionsCustomID(lfgMoreSuggestionsPrefix + "::Counter-Strike 2")
+	require.True(t, ok)
+	require.Equal(t, "Counter-Strike 2", query)
+	require.Zero(t, page)
+	require.False(t, fuzzy)
+
+	cid := suggestionsPageCustomID(3, "Halo: Reach", false)
+	query, page, fuzzy, ok = parseSuggestionsCustomID(cid)
+	require.True(t, ok)
+	require.Equal(t, "Halo: Reach", query)
+	require.Equal(t, 3, page)
+	require.False(t, fuzzy)
+
+	cid = suggestionsPageCustomID(0, "Stardw Valley", true)
+	require.Equal(t, lfgFuzzyPagePrefix+"::0::Stardw Valley", cid)
+	query, page, fuzzy, ok = parseSuggestionsCustomID(cid)
+	require.True(t, ok)
+	require.Equal(t, "Stardw Valley", query)
+	require.Zero(t, page)
+	require.True(t, fuzzy)
+
+	// Queries that would overflow Discord's custom ID limit get no button
+	require.Empty(t, suggestionsPageCustomID(1, strings.Repeat("x", 90), false))
+	require.Empty(t, suggestionsPageCustomID(1, strings.Repeat("x", 90), true))
+
+	for _, bad := range []string{
+		lfgSuggestionsPagePrefix + "::x::Halo",
+		lfgSuggestionsPagePrefix + "::-1::Halo",
+		lfgSuggestionsPagePrefix + "::2",
+		lfgSuggestionsPagePrefix + "::2::",
+		lfgMoreSuggestionsPrefix + "::",
+		lfgFuzzyPagePrefix + "::x::Halo",
+		lfgFuzzyPagePrefix + "::1::",
+		"something_else::Halo",
+	} {
+		_, _, _, ok := parseSuggestionsCustomID(bad)
+		require.Falsef(t, ok, "expected %q to be rejected", bad)
+	}
+}
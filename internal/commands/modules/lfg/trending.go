@@ -0,0 +1,124 @@
+package lfg
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// defaultTrendingDays is the report window when none is picked
+	defaultTrendingDays = 7
+	// trendingMaxListed is how many threads the trending embed links
+	trendingMaxListed = 15
+)
+
+// Trending ranking modes.
+const (
+	trendingByActivity = "activity"
+	trendingByCreated  = "created"
+)
+
+// trendingThreads returns the threads active (or, by created, created) within
+// window of now, most recent first, plus how many qualified in total. At most
+// limit threads are returned. Threads without tracked activity rank by their
+// creation time.
+func trendingThreads(threads []*forumcache.ThreadMeta, now time.Time, window time.Duration, by string, limit int) ([]*forumcache.ThreadMeta, int) {
+	rankTime := func(t *forumcache.ThreadMeta) time.Time {
+		if by == trendingByCreated || t.LastActivity.IsZero() {
+			return t.CreatedAt
+		}
+		return t.LastActivity
+	}
+	since := now.Add(-window)
+	var out []*forumcache.ThreadMeta
+	for _, t := range threads {
+		if rankTime(t).After(since) {
+			out = append(out, t)
+		}
+	}
+	slices.SortFunc(out, func(a, b *forumcache.ThreadMeta) int {
+		if c := rankTime(b).Compare(rankTime(a)); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID, a.ID)
+	})
+	total := len(out)
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, total
+}
+
+// handleLFGTrending reports the most recently active or created LFG threads
+// over a 7 or 30 day window, straight from the forum cache.
+func (m *Module) handleLFGTrending(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+
+	forumID := m.config.ForGuild(i.GuildID).GetGamerPalsLFGForumChannelID()
+	if forumID == "" {
+		respond(utils.T(i.Locale, utils.MsgLFGForumNotConfigured))
+		return
+	}
+
+	days := defaultTrendingDays
+	by := trendingByActivity
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "window":
+			days = int(opt.IntValue())
+		case "rank":
+			by = opt.StringValue()
+		}
+	}
+
+	threads, ok := m.forumCache.ListThreads(forumID)
+	if !ok {
+		respond("❌ LFG forum isn't cached yet. Try `/lfg-admin refresh-thread-cache` first.")
+		return
+	}
+
+	top, total := trendingThreads(threads, time.Now(), time.Duration(days)*24*time.Hour, by, trendingMaxListed)
+
+	title := fmt.Sprintf("Trending LFG threads (last %d days, by activity)", days)
+	stamp := func(t *forumcache.ThreadMeta) time.Time {
+		if t.LastActivity.IsZero() {
+			return t.CreatedAt
+		}
+		return t.LastActivity
+	}
+	if by == trendingByCreated {
+		title = fmt.Sprintf("Newest LFG threads (last %d days)", days)
+		stamp = func(t *forumcache.ThreadMeta) time.Time { return t.CreatedAt }
+	}
+
+	var lines []string
+	for idx, t := range top {
+		lines = append(lines, fmt.Sprintf("%d. <#%s> <t:%d:R>", idx+1, t.ID, stamp(t).Unix()))
+	}
+	desc := "No LFG threads in this window."
+	if len(lines) > 0 {
+		desc = strings.Join(lines, "\n")
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: desc,
+		Color:       utils.Colors.Fancy(),
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("%d of %d cached threads qualified", total, len(threads))},
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Flags: discordgo.MessageFlagsEphemeral},
+	})
+}
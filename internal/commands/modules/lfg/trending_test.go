@@ -0,0 +1,43 @@
+package lfg
+
+import (
+	"testing"
+	"time"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingThreads(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	threads := []*forumcache.ThreadMeta{
+		{ID: "old-busy", CreatedAt: now.Add(-90 * day), LastActivity: now.Add(-time.Hour)},
+		{ID: "new-quiet", CreatedAt: now.Add(-2 * day), LastActivity: now.Add(-2 * day)},
+		{ID: "stale", CreatedAt: now.Add(-60 * day), LastActivity: now.Add(-20 * day)},
+		{ID: "untracked", CreatedAt: now.Add(-3 * day)},
+	}
+	ids := func(ts []*forumcache.ThreadMeta) []string {
+		var out []string
+		for _, t := range ts {
+			out = append(out, t.ID)
+		}
+		return out
+	}
+
+	top, total := trendingThreads(threads, now, 7*day, trendingByActivity, 10)
+	require.Equal(t, []string{"old-busy", "new-quiet", "untracked"}, ids(top))
+	require.Equal(t, 3, total)
+
+	top, _ = trendingThreads(threads, now, 30*day, trendingByActivity, 10)
+	require.Equal(t, []string{"old-busy", "new-quiet", "untracked", "stale"}, ids(top))
+
+	top, total = trendingThreads(threads, now, 7*day, trendingByCreated, 10)
+	require.Equal(t, []string{"new-quiet", "untracked"}, ids(top))
+	require.Equal(t, 2, total)
+
+	top, total = trendingThreads(threads, now, 30*day, trendingByActivity, 2)
+	require.Len(t, top, 2)
+	require.Equal(t, 4, total)
+}
@@ -0,0 +1,158 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/database"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// topCommandsShown caps how many commands are listed in the summary.
+const topCommandsShown = 10
+
+// usageRetention is how long command usage rows are kept: the longest window
+// /metrics can show.
+const usageRetention = 30 * 24 * time.Hour
+
+// windows maps the /metrics window choice to its lookback duration.
+var windows = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": usageRetention,
+}
+
+// Module implements the CommandModule interface for the /metrics command
+type Module struct {
+	db      *database.DB
+	service *Service
+}
+
+// New creates a new metrics module
+func New(deps *types.Dependencies) *Module {
+	return &Module{db: deps.DB, service: NewService(deps.Config, deps.DB)}
+}
+
+// Register adds the /metrics command to the command map
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	var adminPerms int64 = discordgo.PermissionAdministrator
+
+	cmds["metrics"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "metrics",
+			Description:              "Show command usage counts and panic rates",
+			DefaultMemberPermissions: &adminPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "window",
+					Description: "Time window to summarize (default: week)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Last day", Value: "day"},
+						{Name: "Last week", Value: "week"},
+						{Name: "Last month", Value: "month"},
+					},
+				},
+			},
+		},
+		HandlerFunc: m.handleMetrics,
+	}
+}
+
+// Service returns the service that prunes old command usage
+func (m *Module) Service() types.ModuleService { return m.service }
+
+// handleMetrics summarizes recorded command usage over the selected window.
+func (m *Module) handleMetrics(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	window := "week"
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "window" {
+			window = opt.StringValue()
+		}
+	}
+	lookback, ok := windows[window]
+	if !ok {
+		window, lookback = "week", windows["week"]
+	}
+
+	if m.db == nil {
+		respondEphemeral(s, i, "❌ Database not available.")
+		return
+	}
+	stats, err := m.db.GetCommandUsageSince(time.Now().Add(-lookback))
+	if err != nil {
+		respondEphemeral(s, i, fmt.Sprintf("❌ Failed to load metrics: %v", err))
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{buildMetricsEmbed(window, stats)},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// buildMetricsEmbed renders totals, the most used commands, and the commands
+// whose handler panicked for a window. Failures a handler reports to the user
+// without panicking aren't recorded, so they don't show up here.
+func buildMetricsEmbed(window string, stats []database.CommandUsageStat) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📊 Command usage (last %s)", window),
+		Color: utils.Colors.Info(),
+	}
+	if len(stats) == 0 {
+		embed.Description = "No commands recorded in this window."
+		return embed
+	}
+
+	var total, panics int
+	var top, panicking strings.Builder
+	for idx, st := range stats {
+		total += st.Uses
+		panics += st.Panics
+		if idx < topCommandsShown {
+			fmt.Fprintf(&top, "`/%s` — %d\n", st.CommandName, st.Uses)
+		}
+		if st.Panics > 0 {
+			fmt.Fprintf(&panicking, "`/%s` — %d/%d (%s)\n", st.CommandName, st.Panics, st.Uses, percent(st.Panics, st.Uses))
+		}
+	}
+
+	embed.Description = fmt.Sprintf("**%d** invocations across **%d** commands, panic rate **%s**.", total, len(stats), percent(panics, total))
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Top commands", Value: top.String()},
+	}
+	if panicking.Len() > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Panics", Value: truncate(panicking.String(), 1024)})
+	}
+	return embed
+}
+
+func percent(part, whole int) string {
+	if whole == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(whole))
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	})
+}
@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"testing"
+
+	"gamerpal/internal/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMetricsEmbed(t *testing.T) {
+	t.Run("empty window", func(t *testing.T) {
+		embed := buildMetricsEmbed("day", nil)
+		assert.Contains(t, embed.Description, "No commands recorded")
+		assert.Empty(t, embed.Fields)
+	})
+
+	t.Run("totals and panic rates", func(t *testing.T) {
+		embed := buildMetricsEmbed("week", []database.CommandUsageStat{
+			{CommandName: "lfg", Uses: 8, Panics: 2},
+			{CommandName: "ping", Uses: 2},
+		})
+		assert.Contains(t, embed.Title, "last week")
+		assert.Contains(t, embed.Description, "**10** invocations across **2** commands, panic rate **20.0%**")
+		require.Len(t, embed.Fields, 2)
+		assert.Contains(t, embed.Fields[0].Value, "`/lfg` — 8")
+		assert.Contains(t, embed.Fields[1].Value, "`/lfg` — 2/8 (25.0%)")
+		assert.NotContains(t, embed.Fields[1].Value, "ping")
+	})
+}
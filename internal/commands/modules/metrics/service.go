@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/database"
+)
+
+// Service deletes command usage older than usageRetention, so the
+// command_usage table doesn't grow forever.
+type Service struct {
+	types.BaseService
+	cfg *config.Config
+	db  *database.DB
+}
+
+// NewService creates a new command usage retention service
+func NewService(cfg *config.Config, db *database.DB) *Service {
+	return &Service{cfg: cfg, db: db}
+}
+
+// ScheduledFuncs returns functions to be called on a schedule
+func (s *Service) ScheduledFuncs() map[string]func() error {
+	return map[string]func() error{
+		"@daily": s.pruneUsage,
+	}
+}
+
+// pruneUsage deletes command usage rows past the retention window.
+func (s *Service) pruneUsage() error {
+	if s.db == nil {
+		return nil
+	}
+	deleted, err := s.db.DeleteCommandUsageBefore(time.Now().Add(-usageRetention))
+	if err != nil {
+		s.cfg.Logger.Errorf("Failed to prune command usage: %v", err)
+		return err
+	}
+	if deleted > 0 {
+		s.cfg.Logger.Infof("Pruned %d command usage rows older than %s", deleted, usageRetention)
+	}
+	return nil
+}
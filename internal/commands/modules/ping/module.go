@@ -1,17 +1,37 @@
 package ping
 
 import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
 	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 // Module implements the CommandModule interface for the ping command
-type Module struct{}
+type Module struct {
+	config     *config.Config
+	forumCache *forumcache.Service
+	startedAt  atomic.Pointer[time.Time]
+}
 
 // New creates a new ping module
 func New(deps *types.Dependencies) *Module {
-	return &Module{}
+	return &Module{
+		config:     deps.Config,
+		forumCache: deps.ForumCache,
+	}
+}
+
+// SetStartTime records when the bot started so /ping can report uptime.
+func (m *Module) SetStartTime(t time.Time) {
+	m.startedAt.Store(&t)
 }
 
 // Register adds the ping command to the command map
@@ -19,7 +39,7 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 	cmds["ping"] = &types.Command{
 		ApplicationCommand: &discordgo.ApplicationCommand{
 			Name:        "ping",
-			Description: "Check if the bot is responsive",
+			Description: "Check if the bot is responsive and show latency and uptime",
 		},
 		HandlerFunc: m.handlePing,
 	}
@@ -30,11 +50,81 @@ func (m *Module) handlePing(s *discordgo.Session, i *discordgo.InteractionCreate
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: "🏓 Pong! Bot is online and responsive.",
+			Embeds: []*discordgo.MessageEmbed{m.healthEmbed(s.HeartbeatLatency(), time.Now())},
 		},
 	})
 }
 
+// healthEmbed renders gateway latency, uptime and forum cache freshness.
+func (m *Module) healthEmbed(latency time.Duration, now time.Time) *discordgo.MessageEmbed {
+	uptime := "unknown"
+	if started := m.startedAt.Load(); started != nil {
+		uptime = formatUptime(now.Sub(*started))
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Gateway latency", Value: fmt.Sprintf("%dms", latency.Milliseconds()), Inline: true},
+		{Name: "Uptime", Value: uptime, Inline: true},
+	}
+	if syncInfo := m.forumSyncSummary(); syncInfo != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Forum cache sync", Value: syncInfo, Inline: false})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:  "🏓 Pong!",
+		Color:  utils.Colors.Ok(),
+		Fields: fields,
+	}
+}
+
+// forumSyncSummary lists the last full sync of each configured forum, or ""
+// when no forums are cached.
+func (m *Module) forumSyncSummary() string {
+	if m.forumCache == nil || m.config == nil {
+		return ""
+	}
+	forums := []struct{ label, id string }{
+		{"Intros", m.config.GetGamerPalsIntroductionsForumChannelID()},
+		{"LFG", m.config.GetGamerPalsLFGForumChannelID()},
+	}
+	var lines []string
+	for _, f := range forums {
+		if f.id == "" {
+			continue
+		}
+		stats, ok := m.forumCache.Stats(f.id)
+		if !ok {
+			continue
+		}
+		if stats.LastFullSync.IsZero() {
+			lines = append(lines, fmt.Sprintf("%s: never", f.label))
+			continue
+		}
+		line := fmt.Sprintf("%s: <t:%d:R>", f.label, stats.LastFullSync.Unix())
+		if stats.LastSyncTruncated {
+			line += " (truncated at page cap)"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatUptime renders a duration as e.g. "3d 4h 5m".
+func formatUptime(d time.Duration) string {
+	d = d.Truncate(time.Minute)
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh %dm", days, hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
 // Service returns nil as this module has no services requiring initialization
 func (m *Module) Service() types.ModuleService {
 	return nil
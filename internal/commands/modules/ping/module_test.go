@@ -0,0 +1,26 @@
+package ping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUptime(t *testing.T) {
+	assert.Equal(t, "0m", formatUptime(30*time.Second))
+	assert.Equal(t, "2h 5m", formatUptime(2*time.Hour+5*time.Minute))
+	assert.Equal(t, "3d 4h 0m", formatUptime(76*time.Hour))
+}
+
+func TestHealthEmbedUptime(t *testing.T) {
+	m := &Module{}
+	embed := m.healthEmbed(42*time.Millisecond, time.Now())
+	assert.Equal(t, "42ms", embed.Fields[0].Value)
+	assert.Equal(t, "unknown", embed.Fields[1].Value)
+
+	start := time.Now().Add(-90 * time.Minute)
+	m.SetStartTime(start)
+	embed = m.healthEmbed(0, start.Add(90*time.Minute))
+	assert.Equal(t, "1h 30m", embed.Fields[1].Value)
+}
@@ -0,0 +1,155 @@
+package prune
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gamerpal/internal/database"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxAllowlistListed is how many entries /prune-allowlist list shows inline.
+const maxAllowlistListed = 25
+
+// maxMessageLen is Discord's message content limit.
+const maxMessageLen = 2000
+
+// parseThreadRef accepts a thread ID, a <#id> mention or a discord.com
+// channel link and returns the thread ID.
+func parseThreadRef(ref string) (string, bool) {
+	ref = strings.TrimSpace(ref)
+	ref = strings.TrimSuffix(strings.TrimPrefix(ref, "<#"), ">")
+	if idx := strings.LastIndex(strings.TrimRight(ref, "/"), "/"); idx >= 0 {
+		ref = strings.TrimRight(ref, "/")[idx+1:]
+	}
+	if _, err := strconv.ParseUint(ref, 10, 64); err != nil {
+		return "", false
+	}
+	return ref, true
+}
+
+// allowlistListing renders the allowlist as one message, listing at most
+// maxAllowlistListed entries and stopping early so the whole message,
+// including the "and N more" line, stays under Discord's content limit.
+func allowlistListing(entries []database.PruneAllowlistEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Prune allowlist (%d)**", len(entries))
+	// Room for the longest possible "more" line.
+	const moreReserve = len("\n…and 99999 more")
+	for idx, e := range entries {
+		line := fmt.Sprintf("\n• <#%s> (`%s`) added by <@%s>", e.ThreadID, e.ThreadID, e.AddedBy)
+		if e.Note != "" {
+			line += " — " + e.Note
+		}
+		if idx == maxAllowlistListed || b.Len()+len(line)+moreReserve > maxMessageLen {
+			fmt.Fprintf(&b, "\n…and %d more", len(entries)-idx)
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// handlePruneAllowlist manages the threads /prune-forum must never flag.
+func (m *Module) handlePruneAllowlist(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+		if err != nil {
+			m.config.Logger.Errorf("Failed to respond to /prune-allowlist: %v", err)
+		}
+	}
+
+	if m.db == nil {
+		respond("❌ Database is unavailable.")
+		return
+	}
+	data := i.ApplicationCommandData()
+	if len(data.Options) == 0 {
+		respond("❌ Missing subcommand")
+		return
+	}
+	sub := data.Options[0]
+
+	var ref, note string
+	for _, opt := range sub.Options {
+		switch opt.Name {
+		case "thread":
+			ref = opt.StringValue()
+		case "note":
+			note = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	if sub.Name == "list" {
+		entries, err := m.db.ListPruneAllowlist(i.GuildID)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to list prune allowlist: %v", err)
+			respond("❌ Failed to load the allowlist.")
+			return
+		}
+		if len(entries) == 0 {
+			respond("No threads are allowlisted.")
+			return
+		}
+		respond(allowlistListing(entries))
+		return
+	}
+
+	threadID, ok := parseThreadRef(ref)
+	if !ok {
+		respond("❌ Provide a thread ID, mention or link.")
+		return
+	}
+
+	var userID string
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	}
+
+	var content, logMsg string
+	switch sub.Name {
+	case "add":
+		added, err := m.db.AddPruneAllowlistThread(i.GuildID, threadID, userID, note)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to allowlist thread %s: %v", threadID, err)
+			respond("❌ Failed to update the allowlist.")
+			return
+		}
+		if !added {
+			respond(fmt.Sprintf("<#%s> is already allowlisted.", threadID))
+			return
+		}
+		content = fmt.Sprintf("✅ <#%s> will be skipped by /prune-forum.", threadID)
+		logMsg = fmt.Sprintf("<@%s> added <#%s> to the prune allowlist.", userID, threadID)
+		if note != "" {
+			logMsg += " Note: " + note
+		}
+	case "remove":
+		removed, err := m.db.RemovePruneAllowlistThread(i.GuildID, threadID)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to remove allowlisted thread %s: %v", threadID, err)
+			respond("❌ Failed to update the allowlist.")
+			return
+		}
+		if !removed {
+			respond(fmt.Sprintf("<#%s> isn't on the allowlist.", threadID))
+			return
+		}
+		content = fmt.Sprintf("✅ <#%s> removed from the allowlist.", threadID)
+		logMsg = fmt.Sprintf("<@%s> removed <#%s> from the prune allowlist.", userID, threadID)
+	default:
+		respond("❌ Unknown subcommand")
+		return
+	}
+
+	respond(content)
+	if err := utils.LogToChannel(m.config, s, logMsg); err != nil {
+		m.config.Logger.Warnf("Failed to log prune allowlist change: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package prune
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gamerpal/internal/database"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseThreadRef(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{in: "123456789012345678", want: "123456789012345678", wantOK: true},
+		{in: " <#123456789012345678> ", want: "123456789012345678", wantOK: true},
+		{in: "https://discord.com/channels/1/123456789012345678", want: "123456789012345678", wantOK: true},
+		{in: "https://discord.com/channels/1/123456789012345678/", want: "123456789012345678", wantOK: true},
+		{in: "not-a-thread"},
+		{in: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := parseThreadRef(tt.in)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAllowlistListing(t *testing.T) {
+	entry := func(n int, note string) database.PruneAllowlistEntry {
+		return database.PruneAllowlistEntry{ThreadID: fmt.Sprintf("1%017d", n), AddedBy: "123456789012345678", Note: note}
+	}
+
+	short := allowlistListing([]database.PruneAllowlistEntry{entry(1, ""), entry(2, "keep")})
+	require.True(t, strings.HasPrefix(short, "**Prune allowlist (2)**\n• <#100000000000000001>"))
+	require.Contains(t, short, "— keep")
+	require.NotContains(t, short, "more")
+
+	// 25 entries with 200 char notes would be about 7k characters.
+	var long []database.PruneAllowlistEntry
+	for n := range 30 {
+		long = append(long, entry(n, strings.Repeat("n", 200)))
+	}
+	out := allowlistListing(long)
+	require.LessOrEqual(t, len(out), maxMessageLen)
+	listed := strings.Count(out, "\n• ")
+	require.Positive(t, listed)
+	require.Contains(t, out, fmt.Sprintf("…and %d more", 30-listed))
+}
@@ -0,0 +1,48 @@
+package prune
+
+import "gamerpal/internal/config"
+
+// ConfigSettings declares the per-guild settings owned by the prune module,
+// auto-collected into the config panel registry.
+func (m *Module) ConfigSettings() []config.Setting {
+	return []config.Setting{
+		{
+			Key:         config.KeyPruneProtectedRoleIDs,
+			Category:    config.CategoryMisc,
+			Label:       "Prune protected roles",
+			Description: "Members with any of these roles are never removed by /prune-inactive.",
+			Kind:        config.KindRoleList,
+		},
+		{
+			Key:         config.KeyPruneCountedRoleIDs,
+			Category:    config.CategoryMisc,
+			Label:       "Prune counted roles",
+			Description: "Only these roles count toward a member being active. Empty means any role counts.",
+			Kind:        config.KindRoleList,
+		},
+		{
+			Key:         config.KeyPruneReportEnabled,
+			Category:    config.CategoryMisc,
+			Label:       "Prune report enabled",
+			Description: "Post a dry-run summary of /prune-forum and /prune-inactive to the log channel on a schedule.",
+			Kind:        config.KindBool,
+			Default:     false,
+		},
+		{
+			Key:         config.KeyPruneReportInterval,
+			Category:    config.CategoryMisc,
+			Label:       "Prune report interval",
+			Description: "How often the prune report is posted (e.g. 24h, 168h). Minimum 1h.",
+			Kind:        config.KindDuration,
+			Default:     "168h",
+		},
+		{
+			Key:         config.KeyPruneCooldown,
+			Category:    config.CategoryMisc,
+			Label:       "Prune cooldown",
+			Description: "How long before /prune-inactive or /prune-forum can run again in this server (e.g. 2m). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "2m",
+		},
+	}
+}
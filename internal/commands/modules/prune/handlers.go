@@ -3,7 +3,9 @@ package prune
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"gamerpal/internal/utils"
@@ -27,11 +29,17 @@ func (m *Module) handlePruneInactive(s *discordgo.Session, i *discordgo.Interact
 		return
 	}
 
-	// Parse the execute option (defaults to false for dry run)
-	execute := false
-	if len(i.ApplicationCommandData().Options) > 0 {
-		if i.ApplicationCommandData().Options[0].Name == "execute" {
-			execute = i.ApplicationCommandData().Options[0].BoolValue()
+	// Parse options (execute defaults to false for dry run)
+	execute, override := false, false
+	maxKicks := defaultMaxKicks
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "execute":
+			execute = opt.BoolValue()
+		case "max_kicks":
+			maxKicks = int(opt.IntValue())
+		case "override":
+			override = opt.BoolValue()
 		}
 	}
 
@@ -40,51 +48,55 @@ func (m *Module) handlePruneInactive(s *discordgo.Session, i *discordgo.Interact
 		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
 	})
 
-	// Get all guild members
-	members, err := utils.GetAllGuildMembers(s, i.GuildID)
+	// Find users without (counted) roles, excluding bots and protected roles
+	rules := inactiveRulesFor(m.config.ForGuild(i.GuildID))
+	members, usersWithoutRoles, exempted, err := scanInactiveMembers(s, i.GuildID, rules)
 	if err != nil {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{
 			Content: new("❌ Error fetching server members: " + err.Error()),
-		})
+		}, false)
 		return
 	}
 
-	// Find users without roles (excluding bots)
-	var usersWithoutRoles []*discordgo.Member
-	for _, member := range members {
-		if member.User.Bot {
-			continue // Skip bots
-		}
-
-		// Check if user has any roles (besides @everyone which is implicit)
-		if len(member.Roles) == 0 {
-			usersWithoutRoles = append(usersWithoutRoles, member)
-		}
-	}
-
 	// Prepare the response
 	var title, description string
 	var color int
-	var removedCount int
+	var result inactivePruneResult
 
 	if execute {
-		// Actually remove users
 		title = "🔨 Prune Inactive Users - Execution"
 		color = utils.Colors.Warning()
 
-		for _, member := range usersWithoutRoles {
-			err := s.GuildMemberDeleteWithReason(i.GuildID, member.User.ID, "Pruned: User is inactive")
-			if err != nil {
-				m.config.Logger.Warn("Error removing user %s: %v", member.User.Username, err)
-			} else {
-				removedCount++
-				m.config.Logger.Infof("Removed user: %s#%s", member.User.Username, member.User.Discriminator)
-			}
-		}
-
-		if removedCount > 0 {
-			description = fmt.Sprintf("✅ Successfully removed %d users without roles.\n\n", removedCount)
-		} else {
+		result, err = executeInactivePrune(inactivePruneInput{
+			Flagged:  usersWithoutRoles,
+			MaxKicks: maxKicks,
+			Override: override,
+			LogFlagged: func(summary string, csvBytes []byte) error {
+				return utils.LogToChannelWithEmbedAndFile(m.config, s, summary, "prune_inactive_flagged.csv", bytes.NewReader(csvBytes))
+			},
+			Kick: func(userID string) error {
+				err := s.GuildMemberDeleteWithReason(i.GuildID, userID, "Pruned: User is inactive")
+				if err != nil {
+					m.config.Logger.Warnf("Error removing user %s: %v", userID, err)
+				} else {
+					m.config.Logger.Infof("Removed user: %s", userID)
+				}
+				return err
+			},
+			Sleep: time.Sleep,
+		})
+		switch {
+		case errors.Is(err, errMaxKicksExceeded):
+			title = "🛑 Prune Inactive Users - Aborted"
+			description = fmt.Sprintf("Found %d users without roles, which is more than `max_kicks` (%d). Nobody was removed.\n"+
+				"If this looks like a role sync glitch, wait and re-run. Otherwise re-run with a higher `max_kicks` or `override:true`.\n\n",
+				len(usersWithoutRoles), maxKicks)
+		case err != nil:
+			title = "🛑 Prune Inactive Users - Aborted"
+			description = fmt.Sprintf("❌ %v\nNobody was removed; the flagged list must reach the log channel before kicking.\n\n", err)
+		case result.Removed > 0:
+			description = fmt.Sprintf("✅ Successfully removed %d users without roles.\n\n", result.Removed)
+		default:
 			description = "✅ No users were removed.\n\n"
 		}
 	} else {
@@ -110,12 +122,15 @@ func (m *Module) handlePruneInactive(s *discordgo.Session, i *discordgo.Interact
 			description += "\n"
 		}
 
-		if len(usersWithoutRoles) > 10 {
-			description += fmt.Sprintf("... and %d more users\n", len(usersWithoutRoles)-10)
+		if len(usersWithoutRoles) > displayCount {
+			description += fmt.Sprintf("... and %d more users\n", len(usersWithoutRoles)-displayCount)
 		}
 	} else {
 		description += "✅ No users without roles found!"
 	}
+	if len(rules.Counted) > 0 {
+		description += fmt.Sprintf("\n_Only %s count as roles for this check._", roleMentions(rules.Counted))
+	}
 
 	// Create embed response
 	embed := &discordgo.MessageEmbed{
@@ -139,18 +154,66 @@ func (m *Module) handlePruneInactive(s *discordgo.Session, i *discordgo.Interact
 		},
 	}
 
-	if execute && removedCount > 0 {
+	if len(exempted) > 0 {
+		names := make([]string, 0, min(len(exempted), maxInactiveUsersDisplay))
+		for _, member := range exempted[:min(len(exempted), maxInactiveUsersDisplay)] {
+			names = append(names, member.User.Username)
+		}
+		value := strings.Join(names, ", ")
+		if len(exempted) > len(names) {
+			value += fmt.Sprintf(", … and %d more", len(exempted)-len(names))
+		}
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "Users Removed",
-			Value:  fmt.Sprintf("%d", removedCount),
+			Name:  fmt.Sprintf("Exempted by Protected Role (%d)", len(exempted)),
+			Value: value,
+		})
+	}
+
+	if execute && result.Removed > 0 {
+		embed.Fields = append(embed.Fields,
+			&discordgo.MessageEmbedField{
+				Name:   "Users Removed",
+				Value:  fmt.Sprintf("%d", result.Removed),
+				Inline: true,
+			},
+			&discordgo.MessageEmbedField{
+				Name:  "Removed by mistake?",
+				Value: "Kicked members are not banned and can rejoin with a normal invite link. The full list (IDs and names) was posted to the log channel and is attached here.",
+			},
+		)
+	}
+	if execute && result.Failures > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Kick Failures",
+			Value:  fmt.Sprintf("%d", result.Failures),
 			Inline: true,
 		})
 	}
 
+	// Attach the full list so nothing is lost to the display cap
+	files := []*discordgo.File{}
+	if len(usersWithoutRoles) > 0 {
+		if csvBytes, csvErr := buildInactivePruneCSV(usersWithoutRoles); csvErr != nil {
+			m.config.Logger.Warnf("Failed to build CSV for prune-inactive: %v", csvErr)
+		} else {
+			files = append(files, &discordgo.File{Name: "prune_inactive.csv", ContentType: "text/csv", Reader: bytes.NewReader(csvBytes)})
+		}
+	}
+
 	// Send the response
-	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+	_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{
 		Embeds: &[]*discordgo.MessageEmbed{embed},
-	})
+		Files:  files,
+	}, false)
+}
+
+// roleMentions renders role IDs as a comma-separated list of mentions.
+func roleMentions(roleIDs []string) string {
+	mentions := make([]string, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		mentions = append(mentions, "<@&"+id+">")
+	}
+	return strings.Join(mentions, ", ")
 }
 
 // handlePruneForum scans a forum channel for threads from departed owners and duplicate intros.
@@ -213,10 +276,18 @@ func (m *Module) handlePruneForum(s *discordgo.Session, i *discordgo.Interaction
 
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredChannelMessageWithSource})
 
+	// Keep the deferred response alive with throttled progress edits; the
+	// final report below replaces them.
+	progress := newThrottledProgress(progressEditInterval, func(p PruneProgress) {
+		if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(p.String())}); err != nil {
+			m.config.Logger.Warnf("prune-forum: failed to edit progress: %v", err)
+		}
+	})
+
 	// Run the shared prune logic
-	result, err := RunIntroPrune(s, m.config, m.forumCache, forumID, i.GuildID, !execute)
+	result, err := RunIntroPrune(s, m.config, m.forumCache, m.db, forumID, i.GuildID, !execute, progress.report)
 	if err != nil {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(fmt.Sprintf("❌ Error: %v", err))})
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: new(fmt.Sprintf("❌ Error: %v", err))}, false)
 		return
 	}
 
@@ -228,8 +299,8 @@ func (m *Module) handlePruneForum(s *discordgo.Session, i *discordgo.Interaction
 		color = utils.Colors.Warning()
 	}
 
-	description := fmt.Sprintf("Mode: %s\nForum: <#%s>\nThreads scanned: %d\nThreads flagged: %d\nModerator threads skipped: %d",
-		mode, forumID, result.ThreadsScanned, result.ThreadsFlagged, result.ModeratorSkipped)
+	description := fmt.Sprintf("Mode: %s\nForum: <#%s>\nThreads scanned: %d\nThreads flagged: %d\nModerator threads skipped: %d\nAllowlisted threads skipped: %d",
+		mode, forumID, result.ThreadsScanned, result.ThreadsFlagged, result.ModeratorSkipped, result.AllowlistSkipped)
 	if execute {
 		description += fmt.Sprintf("\nThreads deleted: %d\nDelete failures: %d", result.ThreadsDeleted, result.DeleteFailures)
 	}
@@ -278,8 +349,7 @@ func (m *Module) handlePruneForum(s *discordgo.Session, i *discordgo.Interaction
 		files = append(files, &discordgo.File{Name: "forum_prune_report.csv", ContentType: "text/csv", Reader: bytes.NewReader(csvBytes)})
 	}
 
-	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Embeds: &[]*discordgo.MessageEmbed{embed}, Files: files})
-	if err != nil {
+	if err := utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: new(""), Embeds: &[]*discordgo.MessageEmbed{embed}, Files: files}, false); err != nil {
 		m.config.Logger.Errorf("Error sending prune-forum response: %v", err)
 	}
 }
@@ -0,0 +1,155 @@
+package prune
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gamerpal/internal/config"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// defaultMaxKicks is the prune-inactive safety cap used when max_kicks isn't given
+	defaultMaxKicks = 50
+	// memberKickDelay is the delay between kicks in execute mode
+	memberKickDelay = 250 * time.Millisecond
+)
+
+// inactiveRoleRules decides which roles make a member look active.
+type inactiveRoleRules struct {
+	Protected []string // any of these exempts the member outright
+	Counted   []string // only these count as activity; empty means any role
+}
+
+// classifyInactive splits non-bot members into those flagged for pruning and
+// those who would have been flagged but hold a protected role.
+func classifyInactive(members []*discordgo.Member, rules inactiveRoleRules) (flagged, exempt []*discordgo.Member) {
+	for _, member := range members {
+		if member.User == nil || member.User.Bot {
+			continue
+		}
+		active := len(member.Roles) > 0
+		if len(rules.Counted) > 0 {
+			active = hasAnyRole(member, rules.Counted)
+		}
+		if active {
+			continue
+		}
+		if hasAnyRole(member, rules.Protected) {
+			exempt = append(exempt, member)
+		} else {
+			flagged = append(flagged, member)
+		}
+	}
+	return flagged, exempt
+}
+
+// inactiveRulesFor reads the guild's prune-inactive role settings.
+func inactiveRulesFor(gc *config.GuildConfig) inactiveRoleRules {
+	return inactiveRoleRules{Protected: gc.GetPruneProtectedRoleIDs(), Counted: gc.GetPruneCountedRoleIDs()}
+}
+
+// scanInactiveMembers fetches every guild member and classifies them with
+// rules. It never changes anything, so both /prune-inactive and the scheduled
+// prune report can call it.
+func scanInactiveMembers(s *discordgo.Session, guildID string, rules inactiveRoleRules) (members, flagged, exempt []*discordgo.Member, err error) {
+	members, err = utils.GetAllGuildMembers(s, guildID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	flagged, exempt = classifyInactive(members, rules)
+	return members, flagged, exempt, nil
+}
+
+func hasAnyRole(member *discordgo.Member, roleIDs []string) bool {
+	for _, id := range roleIDs {
+		if slices.Contains(member.Roles, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// errMaxKicksExceeded aborts an execute run that would kick more members than allowed.
+var errMaxKicksExceeded = errors.New("flagged member count exceeds max_kicks")
+
+// inactivePruneInput contains all inputs for the testable prune-inactive execution
+type inactivePruneInput struct {
+	Flagged  []*discordgo.Member
+	MaxKicks int
+	Override bool
+	// LogFlagged records the full flagged list before anything is kicked.
+	LogFlagged func(summary string, csv []byte) error
+	Kick       func(userID string) error
+	Sleep      func(time.Duration)
+}
+
+// inactivePruneResult summarizes a prune-inactive execution
+type inactivePruneResult struct {
+	Removed  int
+	Failures int
+}
+
+// executeInactivePrune checks the kick cap, logs the flagged members, then
+// kicks them one at a time. Nothing is kicked if the cap is exceeded or the
+// audit log can't be written.
+func executeInactivePrune(in inactivePruneInput) (inactivePruneResult, error) {
+	var res inactivePruneResult
+	if len(in.Flagged) > in.MaxKicks && !in.Override {
+		return res, fmt.Errorf("%w (%d > %d)", errMaxKicksExceeded, len(in.Flagged), in.MaxKicks)
+	}
+	if len(in.Flagged) == 0 {
+		return res, nil
+	}
+
+	csvBytes, err := buildInactivePruneCSV(in.Flagged)
+	if err != nil {
+		return res, fmt.Errorf("failed to build flagged member CSV: %w", err)
+	}
+	summary := fmt.Sprintf("🔨 **Prune inactive** is about to kick %d members without roles. Full list attached.", len(in.Flagged))
+	if err := in.LogFlagged(summary, csvBytes); err != nil {
+		return res, fmt.Errorf("failed to log flagged members: %w", err)
+	}
+
+	for idx, member := range in.Flagged {
+		if idx > 0 {
+			in.Sleep(memberKickDelay)
+		}
+		if err := in.Kick(member.User.ID); err != nil {
+			res.Failures++
+			continue
+		}
+		res.Removed++
+	}
+	return res, nil
+}
+
+// buildInactivePruneCSV exports flagged members to CSV.
+// Columns: user_id, username, nick, joined_at_iso
+func buildInactivePruneCSV(members []*discordgo.Member) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"user_id", "username", "nick", "joined_at_iso"}); err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		joined := ""
+		if !member.JoinedAt.IsZero() {
+			joined = member.JoinedAt.UTC().Format(time.RFC3339)
+		}
+		if err := w.Write([]string{member.User.ID, member.User.Username, member.Nick, joined}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,129 @@
+package prune
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func members(ids ...string) []*discordgo.Member {
+	out := make([]*discordgo.Member, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, &discordgo.Member{User: &discordgo.User{ID: id, Username: "user" + id}})
+	}
+	return out
+}
+
+func TestExecuteInactivePrune(t *testing.T) {
+	type calls struct {
+		logged []string
+		kicked []string
+		sleeps int
+	}
+	run := func(in inactivePruneInput, logErr error, failKick string) (inactivePruneResult, calls, error) {
+		var c calls
+		in.LogFlagged = func(_ string, csv []byte) error {
+			c.logged = append(c.logged, string(csv))
+			return logErr
+		}
+		in.Kick = func(id string) error {
+			if len(c.logged) == 0 {
+				t.Fatal("kicked before the flagged list was logged")
+			}
+			c.kicked = append(c.kicked, id)
+			if id == failKick {
+				return errors.New("missing permissions")
+			}
+			return nil
+		}
+		in.Sleep = func(time.Duration) { c.sleeps++ }
+		res, err := executeInactivePrune(in)
+		return res, c, err
+	}
+
+	t.Run("logs then kicks with a delay between each", func(t *testing.T) {
+		res, c, err := run(inactivePruneInput{Flagged: members("1", "2", "3"), MaxKicks: 5}, nil, "2")
+		require.NoError(t, err)
+		require.Equal(t, inactivePruneResult{Removed: 2, Failures: 1}, res)
+		require.Equal(t, []string{"1", "2", "3"}, c.kicked)
+		require.Equal(t, 2, c.sleeps)
+		require.Len(t, c.logged, 1)
+		require.True(t, strings.HasPrefix(c.logged[0], "user_id,username,nick,joined_at_iso\n1,user1,,\n"))
+	})
+
+	t.Run("cap aborts before logging or kicking", func(t *testing.T) {
+		_, c, err := run(inactivePruneInput{Flagged: members("1", "2", "3"), MaxKicks: 2}, nil, "")
+		require.ErrorIs(t, err, errMaxKicksExceeded)
+		require.Empty(t, c.logged)
+		require.Empty(t, c.kicked)
+	})
+
+	t.Run("override bypasses the cap", func(t *testing.T) {
+		res, _, err := run(inactivePruneInput{Flagged: members("1", "2", "3"), MaxKicks: 2, Override: true}, nil, "")
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Removed)
+	})
+
+	t.Run("log failure aborts before kicking", func(t *testing.T) {
+		_, c, err := run(inactivePruneInput{Flagged: members("1"), MaxKicks: 5}, errors.New("log channel not set"), "")
+		require.ErrorContains(t, err, "log channel not set")
+		require.Empty(t, c.kicked)
+	})
+}
+
+func TestClassifyInactive(t *testing.T) {
+	member := func(id string, bot bool, roles ...string) *discordgo.Member {
+		return &discordgo.Member{User: &discordgo.User{ID: id, Bot: bot}, Roles: roles}
+	}
+	all := []*discordgo.Member{
+		member("none", false),
+		member("bot", true),
+		member("cosmetic", false, "cosmetic"),
+		member("member", false, "member", "cosmetic"),
+		member("vip", false, "vip"),
+		member("fresh", false),
+	}
+	ids := func(ms []*discordgo.Member) []string {
+		out := []string{}
+		for _, m := range ms {
+			out = append(out, m.User.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		rules       inactiveRoleRules
+		wantFlagged []string
+		wantExempt  []string
+	}{
+		{
+			name:        "any role counts by default",
+			wantFlagged: []string{"none", "fresh"},
+			wantExempt:  []string{},
+		},
+		{
+			name:        "counted roles ignore cosmetic-only members",
+			rules:       inactiveRoleRules{Counted: []string{"member"}},
+			wantFlagged: []string{"none", "cosmetic", "vip", "fresh"},
+			wantExempt:  []string{},
+		},
+		{
+			name:        "protected roles exempt members regardless",
+			rules:       inactiveRoleRules{Counted: []string{"member"}, Protected: []string{"vip", "cosmetic"}},
+			wantFlagged: []string{"none", "fresh"},
+			wantExempt:  []string{"cosmetic", "vip"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagged, exempt := classifyInactive(all, tt.rules)
+			require.Equal(t, tt.wantFlagged, ids(flagged))
+			require.Equal(t, tt.wantExempt, ids(exempt))
+		})
+	}
+}
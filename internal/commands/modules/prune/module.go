@@ -1,8 +1,10 @@
 package prune
 
 import (
+	"fmt"
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
+	"gamerpal/internal/database"
 	"gamerpal/internal/forumcache"
 
 	"github.com/bwmarrin/discordgo"
@@ -12,6 +14,7 @@ import (
 type Module struct {
 	config     *config.Config
 	forumCache *forumcache.Service
+	db         *database.DB
 	service    *Service
 }
 
@@ -20,7 +23,8 @@ func New(deps *types.Dependencies) *Module {
 	return &Module{
 		config:     deps.Config,
 		forumCache: deps.ForumCache,
-		service:    NewService(deps.Config, deps.ForumCache),
+		db:         deps.DB,
+		service:    NewService(deps.Config, deps.ForumCache, deps.DB),
 	}
 }
 
@@ -44,9 +48,23 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 					Description: "Actually remove users (default: false for dry run)",
 					Required:    false,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "max_kicks",
+					Description: fmt.Sprintf("Abort if more users than this would be removed (default: %d)", defaultMaxKicks),
+					Required:    false,
+					MinValue:    new(1.0),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "override",
+					Description: "Remove users even if the count exceeds max_kicks",
+					Required:    false,
+				},
 			},
 		},
 		HandlerFunc: m.handlePruneInactive,
+		Cooldown:    &types.Cooldown{Window: (*config.GuildConfig).GetPruneCooldown, Scope: types.CooldownPerGuild},
 	}
 
 	// Register prune-forum command
@@ -75,6 +93,53 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 			},
 		},
 		HandlerFunc: m.handlePruneForum,
+		Cooldown:    &types.Cooldown{Window: (*config.GuildConfig).GetPruneCooldown, Scope: types.CooldownPerGuild},
+	}
+
+	threadOption := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "thread",
+		Description: "Thread ID, mention or link",
+		Required:    true,
+	}
+
+	// Register prune-allowlist command
+	cmds["prune-allowlist"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "prune-allowlist",
+			Description:              "Manage forum threads that /prune-forum never flags",
+			DefaultMemberPermissions: &modPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Always skip a thread when pruning",
+					Options: []*discordgo.ApplicationCommandOption{
+						threadOption,
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "note",
+							Description: "Why this thread is safe (shown in list)",
+							Required:    false,
+							MaxLength:   200,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop skipping a thread",
+					Options:     []*discordgo.ApplicationCommandOption{threadOption},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show allowlisted threads",
+				},
+			},
+		},
+		HandlerFunc: m.handlePruneAllowlist,
 	}
 }
 
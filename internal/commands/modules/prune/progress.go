@@ -0,0 +1,57 @@
+package prune
+
+import (
+	"fmt"
+	"time"
+)
+
+// progressEditInterval is the minimum gap between progress edits to the
+// deferred /prune-forum response, keeping well under Discord's edit limits.
+const progressEditInterval = 3 * time.Second
+
+// Prune stages reported through PruneProgress.
+const (
+	stageCheckingOwners = "checking owners"
+	stageDeleting       = "deleting"
+)
+
+// PruneProgress is a snapshot of a running forum prune.
+type PruneProgress struct {
+	Stage string
+	// Done and Total count owners while checking, flagged threads while deleting.
+	Done  int
+	Total int
+	// ThreadsDone and Threads count threads covered by the owners checked so far.
+	ThreadsDone int
+	Threads     int
+}
+
+// String renders the progress line shown while a prune runs.
+func (p PruneProgress) String() string {
+	if p.Stage == stageDeleting {
+		return fmt.Sprintf("⏳ Deleting flagged threads: %d/%d…", p.Done, p.Total)
+	}
+	return fmt.Sprintf("⏳ Scanned %d/%d threads (%d/%d owners checked)…", p.ThreadsDone, p.Threads, p.Done, p.Total)
+}
+
+// throttledProgress forwards at most one update per interval to send.
+type throttledProgress struct {
+	interval time.Duration
+	send     func(PruneProgress)
+	now      func() time.Time
+	last     time.Time
+}
+
+func newThrottledProgress(interval time.Duration, send func(PruneProgress)) *throttledProgress {
+	return &throttledProgress{interval: interval, send: send, now: time.Now}
+}
+
+// report sends p unless an update went out less than interval ago.
+func (t *throttledProgress) report(p PruneProgress) {
+	now := t.now()
+	if !t.last.IsZero() && now.Sub(t.last) < t.interval {
+		return
+	}
+	t.last = now
+	t.send(p)
+}
@@ -0,0 +1,52 @@
+package prune
+
+import (
+	"testing"
+	"time"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottledProgress(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var sent []int
+	tp := newThrottledProgress(3*time.Second, func(p PruneProgress) { sent = append(sent, p.Done) })
+	tp.now = func() time.Time { return now }
+
+	tp.report(PruneProgress{Done: 1}) // first update goes out immediately
+	now = now.Add(time.Second)
+	tp.report(PruneProgress{Done: 2})
+	now = now.Add(2 * time.Second)
+	tp.report(PruneProgress{Done: 3})
+	now = now.Add(2 * time.Second)
+	tp.report(PruneProgress{Done: 4})
+
+	require.Equal(t, []int{1, 3}, sent)
+}
+
+func TestPruneProgressString(t *testing.T) {
+	require.Equal(t, "⏳ Scanned 120/450 threads (40/150 owners checked)…",
+		PruneProgress{Stage: stageCheckingOwners, Done: 40, Total: 150, ThreadsDone: 120, Threads: 450}.String())
+	require.Equal(t, "⏳ Deleting flagged threads: 3/8…",
+		PruneProgress{Stage: stageDeleting, Done: 3, Total: 8}.String())
+}
+
+func TestRunIntroPruneReportsDeleteProgress(t *testing.T) {
+	var updates []PruneProgress
+	_, err := runIntroPrune(runIntroPruneInput{
+		Threads: []*forumcache.ThreadMeta{
+			{ID: "1", OwnerID: "gone"},
+			{ID: "2", OwnerID: "gone"},
+		},
+		MemberPresent: map[string]bool{},
+		DeleteThread:  func(string) error { return nil },
+		Progress:      func(p PruneProgress) { updates = append(updates, p) },
+	})
+	require.NoError(t, err)
+	require.Equal(t, []PruneProgress{
+		{Stage: stageDeleting, Done: 0, Total: 2},
+		{Stage: stageDeleting, Done: 1, Total: 2},
+	}, updates)
+}
@@ -0,0 +1,112 @@
+package prune
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/utils"
+)
+
+// pruneReportJob names the scheduled prune report in job_runs.
+const pruneReportJob = "prune_report"
+
+// pruneReport is the dry-run summary posted by the scheduled prune report.
+// Either half may be missing when its scan was skipped or failed.
+type pruneReport struct {
+	ForumID  string
+	Forum    *IntroPruneResult
+	ForumErr error
+
+	MembersChecked int
+	Roleless       int
+	Exempt         int
+	MembersErr     error
+}
+
+// String renders the report as a log-channel message.
+func (r pruneReport) String() string {
+	var b strings.Builder
+	b.WriteString("[Scheduled Prune Report - DRY RUN]\nNothing was deleted or kicked.")
+	switch {
+	case r.ForumID == "":
+		b.WriteString("\nIntro forum: not configured")
+	case r.ForumErr != nil:
+		fmt.Fprintf(&b, "\nIntro forum <#%s>: scan failed (%v)", r.ForumID, r.ForumErr)
+	case r.Forum != nil:
+		fmt.Fprintf(&b, "\nIntro forum <#%s>: %d of %d threads would be flagged (%d moderator, %d allowlisted skipped)",
+			r.ForumID, r.Forum.ThreadsFlagged, r.Forum.ThreadsScanned, r.Forum.ModeratorSkipped, r.Forum.AllowlistSkipped)
+	}
+	if r.MembersErr != nil {
+		fmt.Fprintf(&b, "\nMembers: scan failed (%v)", r.MembersErr)
+	} else {
+		fmt.Fprintf(&b, "\nMembers without roles: %d of %d (%d exempt by protected role)", r.Roleless, r.MembersChecked, r.Exempt)
+	}
+
+	var nudges []string
+	if r.Forum != nil && r.Forum.ThreadsFlagged > 0 {
+		nudges = append(nudges, fmt.Sprintf("`/prune-forum forum:<#%s>`", r.ForumID))
+	}
+	if r.MembersErr == nil && r.Roleless > 0 {
+		nudges = append(nudges, "`/prune-inactive`")
+	}
+	if len(nudges) > 0 {
+		fmt.Fprintf(&b, "\nReview and clean up with %s.", strings.Join(nudges, " and "))
+	}
+	return b.String()
+}
+
+// reportDue reports whether a prune report should be posted at now, given the
+// last one went out at last. Reports line up with every (24h posts just after
+// midnight UTC, 168h on Mondays), so restarting the bot doesn't push the
+// schedule back.
+func reportDue(last, now time.Time, every time.Duration) bool {
+	return now.Truncate(every).After(last.Truncate(every))
+}
+
+// RunScheduledPruneReport posts a dry-run prune report to the log channel when
+// it is enabled and the configured interval has rolled over. It is checked
+// hourly and never deletes or kicks anything.
+func (s *Service) RunScheduledPruneReport() error {
+	if s.Session == nil {
+		return fmt.Errorf("session not initialized")
+	}
+
+	gc := s.cfg.PrimaryGuild()
+	if !gc.GetPruneReportEnabled() || gc.GuildID() == "" {
+		return nil
+	}
+	// The last send is persisted so a restart neither repeats nor skips the
+	// current period; without a database the first tick after start posts.
+	if s.lastReport.IsZero() && s.db != nil {
+		last, err := s.db.GetLastJobRun(pruneReportJob, gc.GuildID())
+		if err != nil {
+			return err
+		}
+		s.lastReport = last
+	}
+	now := time.Now()
+	if !reportDue(s.lastReport, now, gc.GetPruneReportInterval()) {
+		return nil
+	}
+	s.lastReport = now
+	if s.db != nil {
+		if err := s.db.RecordJobRun(pruneReportJob, gc.GuildID(), now); err != nil {
+			s.cfg.Logger.Warnf("[PruneReport] Failed to record report time: %v", err)
+		}
+	}
+
+	report := pruneReport{ForumID: gc.GetGamerPalsIntroductionsForumChannelID()}
+	if report.ForumID != "" {
+		report.Forum, report.ForumErr = RunIntroPrune(s.Session, s.cfg, s.forumCache, s.db, report.ForumID, gc.GuildID(), true, nil)
+	}
+	members, flagged, exempt, err := scanInactiveMembers(s.Session, gc.GuildID(), inactiveRulesFor(gc))
+	report.MembersChecked, report.Roleless, report.Exempt, report.MembersErr = len(members), len(flagged), len(exempt), err
+
+	if err := utils.LogToChannel(s.cfg, s.Session, report.String()); err != nil {
+		s.cfg.Logger.Errorf("[PruneReport] Failed to post report: %v", err)
+		return err
+	}
+	s.cfg.Logger.Infof("[PruneReport] Posted prune report")
+	return nil
+}
@@ -0,0 +1,53 @@
+package prune
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportDue(t *testing.T) {
+	day := 24 * time.Hour
+	week := 7 * day
+	at := func(s string) time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return ts
+	}
+
+	require.False(t, reportDue(at("2026-10-14T09:00:00Z"), at("2026-10-14T23:00:00Z"), day))
+	require.True(t, reportDue(at("2026-10-14T23:00:00Z"), at("2026-10-15T00:00:00Z"), day))
+	// Weekly reports roll over on Monday: 2026-10-19 is a Monday.
+	require.False(t, reportDue(at("2026-10-13T00:00:00Z"), at("2026-10-18T23:00:00Z"), week))
+	require.True(t, reportDue(at("2026-10-18T23:00:00Z"), at("2026-10-19T00:00:00Z"), week))
+}
+
+func TestPruneReportString(t *testing.T) {
+	t.Run("nudges when there is work", func(t *testing.T) {
+		r := pruneReport{
+			ForumID:        "f1",
+			Forum:          &IntroPruneResult{ThreadsScanned: 40, ThreadsFlagged: 3, ModeratorSkipped: 2},
+			MembersChecked: 100,
+			Roleless:       5,
+			Exempt:         1,
+		}
+		out := r.String()
+		require.Contains(t, out, "Intro forum <#f1>: 3 of 40 threads would be flagged (2 moderator, 0 allowlisted skipped)")
+		require.Contains(t, out, "Members without roles: 5 of 100 (1 exempt by protected role)")
+		require.Contains(t, out, "`/prune-forum forum:<#f1>` and `/prune-inactive`")
+	})
+
+	t.Run("failures and nothing to do", func(t *testing.T) {
+		r := pruneReport{ForumID: "f1", ForumErr: errors.New("cache cold"), MembersChecked: 10}
+		out := r.String()
+		require.Contains(t, out, "scan failed (cache cold)")
+		require.Contains(t, out, "Members without roles: 0 of 10")
+		require.NotContains(t, out, "Review and clean up")
+	})
+
+	t.Run("forum not configured", func(t *testing.T) {
+		require.Contains(t, pruneReport{MembersErr: errors.New("boom")}.String(), "Intro forum: not configured")
+	})
+}
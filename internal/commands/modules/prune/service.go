@@ -9,6 +9,7 @@ import (
 
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
+	"gamerpal/internal/database"
 	"gamerpal/internal/forumcache"
 	"gamerpal/internal/utils"
 
@@ -30,6 +31,7 @@ type IntroPruneResult struct {
 	ThreadsDeleted   int
 	DeleteFailures   int
 	ModeratorSkipped int
+	AllowlistSkipped int
 	FlaggedThreads   []FlaggedThread
 }
 
@@ -47,11 +49,13 @@ type runIntroPruneInput struct {
 	Threads        []*forumcache.ThreadMeta
 	MemberPresent  map[string]bool
 	ModeratorIDs   map[string]struct{}
-	OwnerUsernames map[string]string // ownerID -> username
+	Allowlisted    map[string]struct{} // thread IDs that are never flagged
+	OwnerUsernames map[string]string   // ownerID -> username
 	DeleteThread   func(string) error
 	ForumID        string
 	Cfg            *config.Config
 	DryRun         bool
+	Progress       func(PruneProgress) // optional; called before each deletion with the count done so far
 }
 
 // Service handles scheduled intro prune operations
@@ -59,13 +63,16 @@ type Service struct {
 	types.BaseService
 	cfg        *config.Config
 	forumCache *forumcache.Service
+	db         *database.DB
+	lastReport time.Time // when the scheduled prune report last went out; loaded from job_runs
 }
 
 // NewService creates a new prune service
-func NewService(cfg *config.Config, forumCache *forumcache.Service) *Service {
+func NewService(cfg *config.Config, forumCache *forumcache.Service, db *database.DB) *Service {
 	return &Service{
 		cfg:        cfg,
 		forumCache: forumCache,
+		db:         db,
 	}
 }
 
@@ -73,6 +80,7 @@ func NewService(cfg *config.Config, forumCache *forumcache.Service) *Service {
 func (s *Service) ScheduledFuncs() map[string]func() error {
 	return map[string]func() error{
 		"@every 24h": s.RunScheduledIntroPrune,
+		"@hourly":    s.RunScheduledPruneReport,
 	}
 }
 
@@ -94,7 +102,7 @@ func (s *Service) RunScheduledIntroPrune() error {
 
 	s.cfg.Logger.Infof("[IntroPrune] Starting scheduled intro prune (dryRun=%v)...", dryRun)
 
-	result, err := RunIntroPrune(s.Session, s.cfg, s.forumCache, forumID, guildID, dryRun)
+	result, err := RunIntroPrune(s.Session, s.cfg, s.forumCache, s.db, forumID, guildID, dryRun, nil)
 	if err != nil {
 		s.cfg.Logger.Errorf("[IntroPrune] Scheduled prune failed: %v", err)
 		if logErr := utils.LogToChannelWithEmbedAndFile(s.cfg, s.Session, fmt.Sprintf("[Scheduled Intro Prune Failed]\\nError: %v", err), "", nil); logErr != nil {
@@ -108,7 +116,7 @@ func (s *Service) RunScheduledIntroPrune() error {
 	if !dryRun {
 		mode = "EXECUTED"
 	}
-	summary := fmt.Sprintf("[Scheduled Intro Prune - %s]\nForum: <#%s>\nThreads Scanned: %d\nThreads Flagged: %d\nThreads Deleted: %d\nDelete Failures: %d\nModerator Threads Skipped: %d",
+	summary := fmt.Sprintf("[Scheduled Intro Prune - %s]\nForum: <#%s>\nThreads Scanned: %d\nThreads Flagged: %d\nThreads Deleted: %d\nDelete Failures: %d\nModerator Threads Skipped: %d\nAllowlisted Threads Skipped: %d",
 		mode,
 		forumID,
 		result.ThreadsScanned,
@@ -116,6 +124,7 @@ func (s *Service) RunScheduledIntroPrune() error {
 		result.ThreadsDeleted,
 		result.DeleteFailures,
 		result.ModeratorSkipped,
+		result.AllowlistSkipped,
 	)
 
 	// Build CSV with full flagged thread list
@@ -140,12 +149,26 @@ func (s *Service) RunScheduledIntroPrune() error {
 }
 
 // RunIntroPrune runs the consolidated intro prune logic combining duplicates cleanup
-// and departed owner detection. If dryRun is true, no deletions are performed.
-func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumcache.Service, forumID, guildID string, dryRun bool) (*IntroPruneResult, error) {
+// and departed owner detection. Threads on the guild's prune allowlist are never
+// flagged. If dryRun is true, no deletions are performed. progress, if non-nil,
+// is called after each owner check and each deletion.
+func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumcache.Service, db *database.DB, forumID, guildID string, dryRun bool, progress func(PruneProgress)) (*IntroPruneResult, error) {
 	if forumCache == nil {
 		return nil, fmt.Errorf("forum cache unavailable")
 	}
 
+	// Load the allowlist first: without it we can't tell which threads are safe.
+	allowlisted := make(map[string]struct{})
+	if db != nil {
+		entries, err := db.ListPruneAllowlist(guildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load prune allowlist: %w", err)
+		}
+		for _, e := range entries {
+			allowlisted[e.ThreadID] = struct{}{}
+		}
+	}
+
 	// Ensure forum is registered in cache
 	forumCache.RegisterForum(forumID)
 
@@ -155,10 +178,10 @@ func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumca
 		return nil, fmt.Errorf("forum cache not populated for forum %s", forumID)
 	}
 
-	// Build owner set from cached threads
-	ownerSet := make(map[string]struct{})
+	// Build owner set (with thread counts, for progress) from cached threads
+	ownerSet := make(map[string]int)
 	for _, tm := range threads {
-		ownerSet[tm.OwnerID] = struct{}{}
+		ownerSet[tm.OwnerID]++
 	}
 
 	// Pre-compute membership, moderator status, and usernames from Discord API
@@ -166,7 +189,8 @@ func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumca
 	moderatorIDs := make(map[string]struct{})
 	ownerUsernames := make(map[string]string, len(ownerSet))
 
-	for ownerID := range ownerSet {
+	ownersDone, threadsDone := 0, 0
+	for ownerID, ownerThreads := range ownerSet {
 		// Check membership: GuildMember returns error if user not present
 		if member, err := s.GuildMember(guildID, ownerID); err == nil {
 			memberPresent[ownerID] = true
@@ -184,12 +208,19 @@ func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumca
 		if perms, err := s.UserChannelPermissions(ownerID, forumID); err == nil && (perms&discordgo.PermissionBanMembers) != 0 {
 			moderatorIDs[ownerID] = struct{}{}
 		}
+		ownersDone++
+		threadsDone += ownerThreads
+		if progress != nil {
+			progress(PruneProgress{Stage: stageCheckingOwners, Done: ownersDone, Total: len(ownerSet), ThreadsDone: threadsDone, Threads: len(threads)})
+		}
 		time.Sleep(ownerCheckDelay)
 	}
 
 	// Delete callback wrapping Discord API
 	deleteThread := func(threadID string) error {
-		_, err := s.ChannelDelete(threadID)
+		_, err := utils.WithRateLimitRetry(func() (*discordgo.Channel, error) {
+			return s.ChannelDelete(threadID)
+		})
 		return err
 	}
 
@@ -197,21 +228,43 @@ func RunIntroPrune(s *discordgo.Session, cfg *config.Config, forumCache *forumca
 		Threads:        threads,
 		MemberPresent:  memberPresent,
 		ModeratorIDs:   moderatorIDs,
+		Allowlisted:    allowlisted,
 		OwnerUsernames: ownerUsernames,
 		DeleteThread:   deleteThread,
 		ForumID:        forumID,
 		Cfg:            cfg,
 		DryRun:         dryRun,
+		Progress:       progress,
 	})
 }
 
-// runIntroPrune is the testable core logic operating on pre-computed data.
-func runIntroPrune(input runIntroPruneInput) (*IntroPruneResult, error) {
-	result := &IntroPruneResult{
-		ThreadsScanned: len(input.Threads),
-	}
+// forumScan is the outcome of scanForumForPrune.
+type forumScan struct {
+	Flagged          []FlaggedThread // newest first by thread ID
+	ModeratorSkipped int
+	AllowlistSkipped int
+}
 
-	var flaggedThreads []FlaggedThread
+// scanForumForPrune decides which threads would be pruned from pre-fetched
+// owner data, without touching Discord. Moderator-owned threads are skipped,
+// every thread of a departed owner is flagged, and for present owners all but
+// their newest thread are flagged as duplicates. Allowlisted threads are
+// never flagged.
+func scanForumForPrune(input runIntroPruneInput) forumScan {
+	var scan forumScan
+	flag := func(meta *forumcache.ThreadMeta, reason string) {
+		if _, ok := input.Allowlisted[meta.ID]; ok {
+			scan.AllowlistSkipped++
+			return
+		}
+		scan.Flagged = append(scan.Flagged, FlaggedThread{
+			ThreadID:  meta.ID,
+			Reason:    reason,
+			OwnerID:   meta.OwnerID,
+			Username:  input.OwnerUsernames[meta.OwnerID],
+			CreatedAt: meta.CreatedAt,
+		})
+	}
 
 	// Group threads by owner
 	byOwner := make(map[string][]*forumcache.ThreadMeta)
@@ -223,20 +276,14 @@ func runIntroPrune(input runIntroPruneInput) (*IntroPruneResult, error) {
 	for ownerID, metas := range byOwner {
 		// Skip moderator owners
 		if _, isMod := input.ModeratorIDs[ownerID]; isMod {
-			result.ModeratorSkipped += len(metas)
+			scan.ModeratorSkipped += len(metas)
 			continue
 		}
 
 		// Departed owner: flag all threads
 		if !input.MemberPresent[ownerID] {
 			for _, meta := range metas {
-				flaggedThreads = append(flaggedThreads, FlaggedThread{
-					ThreadID:  meta.ID,
-					Reason:    "owner departed",
-					OwnerID:   ownerID,
-					Username:  input.OwnerUsernames[ownerID],
-					CreatedAt: meta.CreatedAt,
-				})
+				flag(meta, "owner departed")
 			}
 			continue
 		}
@@ -256,26 +303,36 @@ func runIntroPrune(input runIntroPruneInput) (*IntroPruneResult, error) {
 		})
 
 		for _, meta := range metas[:len(metas)-1] { // all but newest
-			flaggedThreads = append(flaggedThreads, FlaggedThread{
-				ThreadID:  meta.ID,
-				Reason:    "duplicate (older thread)",
-				OwnerID:   ownerID,
-				Username:  input.OwnerUsernames[ownerID],
-				CreatedAt: meta.CreatedAt,
-			})
+			flag(meta, "duplicate (older thread)")
 		}
 	}
 
 	// Sort flagged threads for consistent ordering (newest first by ID)
-	sort.Slice(flaggedThreads, func(a, b int) bool {
-		return flaggedThreads[a].ThreadID > flaggedThreads[b].ThreadID
+	sort.Slice(scan.Flagged, func(a, b int) bool {
+		return scan.Flagged[a].ThreadID > scan.Flagged[b].ThreadID
 	})
+	return scan
+}
 
-	result.ThreadsFlagged = len(flaggedThreads)
+// runIntroPrune is the testable core logic operating on pre-computed data: it
+// scans with scanForumForPrune and, unless DryRun is set, deletes the flagged
+// threads.
+func runIntroPrune(input runIntroPruneInput) (*IntroPruneResult, error) {
+	scan := scanForumForPrune(input)
+	flaggedThreads := scan.Flagged
+	result := &IntroPruneResult{
+		ThreadsScanned:   len(input.Threads),
+		ThreadsFlagged:   len(flaggedThreads),
+		ModeratorSkipped: scan.ModeratorSkipped,
+		AllowlistSkipped: scan.AllowlistSkipped,
+	}
 
 	// Execute deletions (skip in dry run mode)
 	if !input.DryRun {
-		for _, f := range flaggedThreads {
+		for idx, f := range flaggedThreads {
+			if input.Progress != nil {
+				input.Progress(PruneProgress{Stage: stageDeleting, Done: idx, Total: len(flaggedThreads)})
+			}
 			if err := input.DeleteThread(f.ThreadID); err != nil {
 				result.DeleteFailures++
 				if input.Cfg != nil && input.Cfg.Logger != nil {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"gamerpal/internal/forumcache"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunIntroPrune(t *testing.T) {
@@ -21,6 +23,7 @@ func TestRunIntroPrune(t *testing.T) {
 		wantDeleted    int
 		wantFailures   int
 		wantModSkipped int
+		wantAllowSkip  int
 		wantDeletedIDs []string
 		wantKeptIDs    []string
 		wantReasons    []string // expected reasons for flagged threads
@@ -208,6 +211,26 @@ func TestRunIntroPrune(t *testing.T) {
 			wantKeptIDs:    []string{"thread1", "thread2"}, // both kept in dry run
 			wantReasons:    []string{"duplicate (older thread)"},
 		},
+		{
+			name: "allowlisted threads never flagged",
+			input: runIntroPruneInput{
+				Threads: []*forumcache.ThreadMeta{
+					{ID: "thread1", ForumID: "forum1", OwnerID: "departed_user", CreatedAt: now},
+					{ID: "thread2", ForumID: "forum1", OwnerID: "user1", CreatedAt: now.Add(-time.Hour)},
+					{ID: "thread3", ForumID: "forum1", OwnerID: "user1", CreatedAt: now},
+				},
+				MemberPresent: map[string]bool{"departed_user": false, "user1": true},
+				ModeratorIDs:  map[string]struct{}{},
+				Allowlisted:   map[string]struct{}{"thread1": {}, "thread2": {}},
+				ForumID:       "forum1",
+			},
+			wantScanned:    3,
+			wantFlagged:    0,
+			wantDeleted:    0,
+			wantAllowSkip:  2,
+			wantDeletedIDs: []string{},
+			wantKeptIDs:    []string{"thread1", "thread2", "thread3"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -241,6 +264,9 @@ func TestRunIntroPrune(t *testing.T) {
 			if result.ModeratorSkipped != tt.wantModSkipped {
 				t.Errorf("ModeratorSkipped = %d, want %d", result.ModeratorSkipped, tt.wantModSkipped)
 			}
+			if result.AllowlistSkipped != tt.wantAllowSkip {
+				t.Errorf("AllowlistSkipped = %d, want %d", result.AllowlistSkipped, tt.wantAllowSkip)
+			}
 
 			// Check expected deletions
 			deletedSet := make(map[string]bool)
@@ -284,3 +310,67 @@ func TestRunIntroPrune(t *testing.T) {
 		})
 	}
 }
+
+func TestScanForumForPrune(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	thread := func(id, owner string, age time.Duration) *forumcache.ThreadMeta {
+		return &forumcache.ThreadMeta{ID: id, OwnerID: owner, CreatedAt: base.Add(-age)}
+	}
+
+	tests := []struct {
+		name          string
+		input         runIntroPruneInput
+		want          []string // "threadID:reason", newest ID first
+		wantModSkip   int
+		wantAllowSkip int
+	}{
+		{
+			name: "departed owner flags every thread",
+			input: runIntroPruneInput{
+				Threads:       []*forumcache.ThreadMeta{thread("10", "gone", time.Hour), thread("11", "gone", 0)},
+				MemberPresent: map[string]bool{},
+			},
+			want: []string{"11:owner departed", "10:owner departed"},
+		},
+		{
+			name: "present owner keeps only the newest",
+			input: runIntroPruneInput{
+				Threads:       []*forumcache.ThreadMeta{thread("20", "u1", 0), thread("21", "u1", 2*time.Hour), thread("22", "u1", time.Hour)},
+				MemberPresent: map[string]bool{"u1": true},
+			},
+			want: []string{"22:duplicate (older thread)", "21:duplicate (older thread)"},
+		},
+		{
+			name: "moderators are never flagged",
+			input: runIntroPruneInput{
+				Threads:       []*forumcache.ThreadMeta{thread("30", "mod", 0), thread("31", "mod", time.Hour)},
+				MemberPresent: map[string]bool{},
+				ModeratorIDs:  map[string]struct{}{"mod": {}},
+			},
+			wantModSkip: 2,
+		},
+		{
+			name: "allowlist beats departed owner",
+			input: runIntroPruneInput{
+				Threads:       []*forumcache.ThreadMeta{thread("40", "gone", 0), thread("41", "gone", time.Hour)},
+				MemberPresent: map[string]bool{},
+				Allowlisted:   map[string]struct{}{"40": {}},
+			},
+			want:          []string{"41:owner departed"},
+			wantAllowSkip: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scan := scanForumForPrune(tt.input)
+			var got []string
+			for _, f := range scan.Flagged {
+				got = append(got, f.ThreadID+":"+f.Reason)
+			}
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantModSkip, scan.ModeratorSkipped)
+			require.Equal(t, tt.wantAllowSkip, scan.AllowlistSkipped)
+		})
+	}
+}
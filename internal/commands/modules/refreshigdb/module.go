@@ -1,30 +1,29 @@
 package refreshigdb
 
 import (
-	"encoding/json"
 	"fmt"
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
+	"gamerpal/internal/games"
 	"gamerpal/internal/utils"
-	"io"
-	"net/http"
-	"net/url"
 	"time"
 
-	"github.com/Henry-Sarabia/igdb/v2"
 	"github.com/bwmarrin/discordgo"
 )
 
 // Module implements the CommandModule interface for the refresh-igdb command
 type Module struct {
-	config     *config.Config
-	igdbClient **igdb.Client // Pointer to the client pointer so we can update it
+	config  *config.Config
+	tokens  *games.TokenManager
+	service *Service
 }
 
 // New creates a new refresh-igdb module
 func New(deps *types.Dependencies) *Module {
 	return &Module{
-		config: deps.Config,
+		config:  deps.Config,
+		tokens:  deps.IGDBTokens,
+		service: NewService(deps.Config, deps.IGDBTokens),
 	}
 }
 
@@ -45,11 +44,6 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 	}
 }
 
-// SetIGDBClientRef sets a reference to the IGDB client pointer
-func (m *Module) SetIGDBClientRef(client **igdb.Client) {
-	m.igdbClient = client
-}
-
 // handleRefreshIGDB refreshes the IGDB access token using the stored client ID and client secret.
 // Only usable in bot DM context by super admins.
 func (m *Module) handleRefreshIGDB(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -67,80 +61,26 @@ func (m *Module) handleRefreshIGDB(s *discordgo.Session, i *discordgo.Interactio
 		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
 	})
 
-	clientID := m.config.GetIGDBClientID()
-	secret := m.config.GetIGDBClientSecret()
-	if clientID == "" || secret == "" {
+	if m.tokens == nil || !m.tokens.CanRefresh() {
 		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new("❌ Missing igdb_client_id or igdb_client_secret in configuration.")})
 		return
 	}
 
-	token, expiresIn, err := m.fetchTwitchAppToken(clientID, secret)
+	ttl, err := m.tokens.Refresh()
 	if err != nil {
 		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(fmt.Sprintf("❌ Failed to refresh token: %v", err))})
 		return
 	}
+	// Keep the config value in step for anything that reads it directly.
+	// Persistence comes from the env var on next start.
+	m.config.Set("igdb_client_token", m.tokens.Token())
+	m.config.Logger.Infof("IGDB token refreshed manually; expires in %s", ttl.Round(time.Minute))
 
-	// Update the in-memory token for this process; the IGDB client below is
-	// recreated to use it. Persistence comes from the env var on next start.
-	m.config.Set("igdb_client_token", token)
-
-	// Recreate IGDB client with new token if we have a reference
-	if m.igdbClient != nil {
-		*m.igdbClient = igdb.NewClient(clientID, token, nil)
-	}
-
-	msg := fmt.Sprintf("✅ IGDB token refreshed for this session.\nExpires In: %.2f hours", (time.Duration(expiresIn) * time.Second).Hours())
+	msg := fmt.Sprintf("✅ IGDB token refreshed for this session.\nExpires In: %.2f hours", ttl.Hours())
 	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: new(msg)})
 }
 
-// fetchTwitchAppToken requests a new app access token from Twitch/IGDB.
-func (m *Module) fetchTwitchAppToken(clientID, clientSecret string) (token string, expiresIn int, err error) {
-	u, err := url.Parse("https://id.twitch.tv/oauth2/token")
-	if err != nil {
-		return "", 0, err
-	}
-	q := u.Query()
-	q.Set("client_id", clientID)
-	q.Set("client_secret", clientSecret)
-	q.Set("grant_type", "client_credentials")
-	u.RawQuery = q.Encode()
-
-	req, err := http.NewRequest(http.MethodPost, u.String(), nil)
-	if err != nil {
-		return "", 0, err
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", 0, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		bodyStr := string(body)
-		if len(bodyStr) > 200 {
-			bodyStr = bodyStr[:200] + "..."
-		}
-		return "", 0, fmt.Errorf("twitch token endpoint returned %d: %s", resp.StatusCode, bodyStr)
-	}
-
-	var parsed struct {
-		AccessToken string `json:"access_token"`
-		ExpiresIn   int    `json:"expires_in"`
-		TokenType   string `json:"token_type"`
-	}
-	if err := json.Unmarshal(body, &parsed); err != nil {
-		return "", 0, err
-	}
-	if parsed.AccessToken == "" {
-		return "", 0, fmt.Errorf("empty access_token in response")
-	}
-	return parsed.AccessToken, parsed.ExpiresIn, nil
-}
-
-// Service returns nil as this module has no services requiring initialization
+// Service returns the background token refresh service
 func (m *Module) Service() types.ModuleService {
-	return nil
+	return m.service
 }
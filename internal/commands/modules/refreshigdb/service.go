@@ -0,0 +1,62 @@
+package refreshigdb
+
+import (
+	"fmt"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/games"
+	"gamerpal/internal/utils"
+)
+
+// tokenRefreshMargin is how long before expiry the token is proactively
+// refreshed. Twitch app tokens last about 60 days, so a day leaves plenty of
+// hourly retries if Twitch is briefly unreachable.
+const tokenRefreshMargin = 24 * time.Hour
+
+// Service keeps the IGDB token fresh in the background. /refresh-igdb remains
+// as a manual fallback.
+type Service struct {
+	types.BaseService
+	cfg     *config.Config
+	tokens  *games.TokenManager
+	failing bool // true after a failed refresh, so the log channel hears about it once
+}
+
+// NewService creates a new IGDB token refresh service
+func NewService(cfg *config.Config, tokens *games.TokenManager) *Service {
+	return &Service{cfg: cfg, tokens: tokens}
+}
+
+// ScheduledFuncs returns functions to be called on a schedule
+func (s *Service) ScheduledFuncs() map[string]func() error {
+	return map[string]func() error{
+		"@every 1h": s.refreshIfExpiring,
+	}
+}
+
+// refreshIfExpiring refreshes the token when it is close to expiry, or when
+// its expiry is unknown because it came from config.
+func (s *Service) refreshIfExpiring() error {
+	if s.tokens == nil || !s.tokens.CanRefresh() {
+		return nil
+	}
+	refreshed, err := s.tokens.RefreshIfExpiring(tokenRefreshMargin)
+	if err != nil {
+		s.cfg.Logger.Errorf("IGDB token refresh failed: %v", err)
+		if !s.failing && s.Session != nil {
+			msg := fmt.Sprintf("⚠️ Automatic IGDB token refresh failed: %v\nGame lookups may start failing; retrying hourly. `/refresh-igdb` can be used manually.", err)
+			if logErr := utils.LogToChannel(s.cfg, s.Session, msg); logErr != nil {
+				s.cfg.Logger.Warnf("Failed to log IGDB token refresh failure: %v", logErr)
+			}
+		}
+		s.failing = true
+		return err
+	}
+	s.failing = false
+	if refreshed {
+		s.cfg.Logger.Infof("IGDB token refreshed; expires %s", s.tokens.ExpiresAt().UTC().Format(time.RFC3339))
+	}
+	return nil
+}
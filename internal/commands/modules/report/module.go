@@ -0,0 +1,372 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	reportThreadCommandName = "Report thread"
+
+	// Custom IDs are routed to this module by their "report:" prefix.
+	modalPrefix         = "report:modal:"   // report:modal:<threadID>
+	dismissPrefix       = "report:dismiss:" // report:dismiss:<threadID>
+	deletePrefix        = "report:delete:"  // report:delete:<threadID>
+	reasonInputCustomID = "report_reason"
+
+	maxReasonsShown = 5
+
+	// reportExpiry is how long an open report is kept without new reports.
+	// After that, a new report on the thread starts a fresh mod-log entry.
+	reportExpiry = 7 * 24 * time.Hour
+	// reportPruneInterval is how often expired reports are dropped.
+	reportPruneInterval = time.Hour
+)
+
+// threadReport aggregates every report for a single thread into one mod-log entry.
+type threadReport struct {
+	// mu serializes reports on this thread, including the Discord calls that
+	// post or update its entry. The fields below are guarded by mu.
+	mu           sync.Mutex
+	ThreadID     string
+	LogChannelID string
+	LogMessageID string
+	Reporters    []string // user IDs, in report order
+	Reasons      []string // non-empty reasons, in report order
+
+	// Guarded by Module.mu. closed is set once the report is dismissed,
+	// expires or fails to post, so a queued report starts a fresh entry
+	// instead of reviving it.
+	closed     bool
+	lastReport time.Time
+}
+
+// Module implements the CommandModule interface for the "Report thread"
+// message context command.
+type Module struct {
+	config *config.Config
+
+	mu        sync.Mutex
+	reports   map[string]*threadReport // threadID → open report
+	lastPrune time.Time
+}
+
+// New creates a new report module
+func New(deps *types.Dependencies) *Module {
+	return &Module{
+		config:  deps.Config,
+		reports: make(map[string]*threadReport),
+	}
+}
+
+// Register adds the "Report thread" message context command
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	cmds[reportThreadCommandName] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:     reportThreadCommandName,
+			Type:     discordgo.MessageApplicationCommand,
+			Contexts: &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+		},
+		HandlerFunc: m.handleReportThread,
+	}
+}
+
+// Service returns nil; this module has no recurring service.
+func (m *Module) Service() types.ModuleService { return nil }
+
+// handleReportThread opens the optional-reason modal for a forum thread.
+func (m *Module) handleReportThread(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ch, err := s.Channel(i.ChannelID)
+	if err != nil || ch == nil || !ch.IsThread() || !m.isForumThread(s, ch) {
+		respondEphemeral(s, i, "❌ Report thread only works on messages inside a forum thread.")
+		return
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: modalPrefix + ch.ID,
+			Title:    "Report thread",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					discordgo.TextInput{
+						CustomID:    reasonInputCustomID,
+						Label:       "Reason (optional)",
+						Style:       discordgo.TextInputParagraph,
+						Placeholder: "What's wrong with this thread?",
+						Required:    false,
+						MaxLength:   500,
+					},
+				}},
+			},
+		},
+	})
+}
+
+// isForumThread reports whether the thread's parent is a forum channel.
+func (m *Module) isForumThread(s *discordgo.Session, thread *discordgo.Channel) bool {
+	parent, err := s.Channel(thread.ParentID)
+	return err == nil && parent != nil && parent.Type == discordgo.ChannelTypeGuildForum
+}
+
+// HandleModalSubmit records a report and posts or updates the mod-log entry.
+func (m *Module) HandleModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ModalSubmitData()
+	threadID := strings.TrimPrefix(data.CustomID, modalPrefix)
+	if threadID == data.CustomID || threadID == "" || i.Member == nil || i.Member.User == nil {
+		return
+	}
+	reason := strings.TrimSpace(modalTextValue(data.Components, reasonInputCustomID))
+
+	logChannelID := m.config.ForGuild(i.GuildID).GetGamerPalsModActionLogChannelID()
+	if logChannelID == "" {
+		respondEphemeral(s, i, "❌ Reports aren't set up on this server. Please contact a moderator directly.")
+		return
+	}
+
+	// Posting can be slow or rate limited, so answer within Discord's
+	// deadline first and fill in the outcome afterwards.
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+
+	content := "✅ Thanks! The moderators have been notified."
+	if err := m.submitReport(s, i.GuildID, logChannelID, threadID, i.Member.User.ID, reason); err != nil {
+		content = "❌ Couldn't send your report. Please try again later."
+	}
+	_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{Content: &content}, true)
+}
+
+// submitReport adds a report to the thread's entry and posts or updates it in
+// the mod log. Only reports on the same thread wait for each other.
+func (m *Module) submitReport(s *discordgo.Session, guildID, logChannelID, threadID, reporterID, reason string) error {
+	for {
+		rep := m.openReport(threadID, time.Now())
+		rep.mu.Lock()
+		if m.isClosed(rep) {
+			// Dismissed or failed while we waited; start a fresh entry.
+			rep.mu.Unlock()
+			continue
+		}
+		err := m.postReport(s, guildID, logChannelID, rep, reporterID, reason)
+		rep.mu.Unlock()
+		return err
+	}
+}
+
+// postReport records the report and posts or updates the entry. Callers must
+// hold rep.mu.
+func (m *Module) postReport(s *discordgo.Session, guildID, logChannelID string, rep *threadReport, reporterID, reason string) error {
+	isNew := addReporter(rep, reporterID, reason)
+	embed := reportEmbed(guildID, rep)
+	if !isNew {
+		if _, err := s.ChannelMessageEditEmbed(rep.LogChannelID, rep.LogMessageID, embed); err != nil {
+			m.config.Logger.Warnf("report: failed to update report for thread %s: %v", rep.ThreadID, err)
+		}
+		return nil
+	}
+	msg, err := s.ChannelMessageSendComplex(logChannelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: reportComponents(rep.ThreadID),
+	})
+	if err != nil {
+		m.closeReport(rep)
+		m.config.Logger.Errorf("report: failed to post report for thread %s: %v", rep.ThreadID, err)
+		return err
+	}
+	rep.LogChannelID = logChannelID
+	rep.LogMessageID = msg.ID
+	return nil
+}
+
+// openReport returns the thread's open report, creating it if needed, and
+// marks it as reported at now. Reports idle for longer than reportExpiry are
+// dropped along the way.
+func (m *Module) openReport(threadID string, now time.Time) *threadReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.Sub(m.lastPrune) >= reportPruneInterval {
+		for id, rep := range m.reports {
+			if now.Sub(rep.lastReport) >= reportExpiry {
+				rep.closed = true
+				delete(m.reports, id)
+			}
+		}
+		m.lastPrune = now
+	}
+	rep, ok := m.reports[threadID]
+	if !ok {
+		rep = &threadReport{ThreadID: threadID}
+		m.reports[threadID] = rep
+	}
+	rep.lastReport = now
+	return rep
+}
+
+// closeReport removes rep from the open reports and marks it closed.
+func (m *Module) closeReport(rep *threadReport) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rep.closed = true
+	if m.reports[rep.ThreadID] == rep {
+		delete(m.reports, rep.ThreadID)
+	}
+}
+
+// isClosed reports whether rep was closed.
+func (m *Module) isClosed(rep *threadReport) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return rep.closed
+}
+
+// addReporter merges a report into the entry and reports whether the entry
+// still has to be posted. Repeat reports from the same user only add their
+// reason. Callers must hold rep.mu.
+func addReporter(rep *threadReport, reporterID, reason string) bool {
+	alreadyReported := false
+	for _, id := range rep.Reporters {
+		if id == reporterID {
+			alreadyReported = true
+			break
+		}
+	}
+	if !alreadyReported {
+		rep.Reporters = append(rep.Reporters, reporterID)
+	}
+	if reason != "" {
+		rep.Reasons = append(rep.Reasons, fmt.Sprintf("<@%s>: %s", reporterID, reason))
+	}
+	return rep.LogMessageID == ""
+}
+
+// HandleComponent handles the Dismiss / Delete thread buttons on a report.
+func (m *Module) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	cid := i.MessageComponentData().CustomID
+	if i.Member == nil || i.Member.User == nil || i.Member.Permissions&discordgo.PermissionBanMembers == 0 {
+		respondEphemeral(s, i, "❌ Only moderators can act on reports.")
+		return
+	}
+
+	var threadID, outcome string
+	switch {
+	case strings.HasPrefix(cid, dismissPrefix):
+		threadID = strings.TrimPrefix(cid, dismissPrefix)
+		outcome = fmt.Sprintf("Dismissed by %s", i.Member.User.Username)
+	case strings.HasPrefix(cid, deletePrefix):
+		threadID = strings.TrimPrefix(cid, deletePrefix)
+		if _, err := s.ChannelDelete(threadID); err != nil {
+			respondEphemeral(s, i, fmt.Sprintf("❌ Failed to delete the thread: %v", err))
+			return
+		}
+		outcome = fmt.Sprintf("Thread deleted by %s", i.Member.User.Username)
+	default:
+		return
+	}
+
+	m.mu.Lock()
+	if rep, ok := m.reports[threadID]; ok {
+		rep.closed = true
+		delete(m.reports, threadID)
+	}
+	m.mu.Unlock()
+
+	// Close out the report message: keep the details, drop the buttons.
+	var embeds []*discordgo.MessageEmbed
+	if i.Message != nil && len(i.Message.Embeds) > 0 {
+		embed := *i.Message.Embeds[0]
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: outcome}
+		embed.Color = utils.Colors.Ok()
+		embeds = []*discordgo.MessageEmbed{&embed}
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{Embeds: embeds, Components: []discordgo.MessageComponent{}},
+	})
+}
+
+// reportEmbed renders the mod-log entry for a thread report.
+func reportEmbed(guildID string, rep *threadReport) *discordgo.MessageEmbed {
+	threadURL := fmt.Sprintf("https://discord.com/channels/%s/%s", guildID, rep.ThreadID)
+	reporters := make([]string, 0, len(rep.Reporters))
+	for _, id := range rep.Reporters {
+		reporters = append(reporters, fmt.Sprintf("<@%s>", id))
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Thread", Value: fmt.Sprintf("<#%s>\n%s", rep.ThreadID, threadURL), Inline: false},
+		{Name: fmt.Sprintf("Reporters (%d)", len(rep.Reporters)), Value: truncate(strings.Join(reporters, ", "), 1024), Inline: false},
+	}
+	if len(rep.Reasons) > 0 {
+		reasons := rep.Reasons
+		if len(reasons) > maxReasonsShown {
+			reasons = reasons[len(reasons)-maxReasonsShown:]
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Reasons", Value: truncate(strings.Join(reasons, "\n"), 1024), Inline: false})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:     "🚩 Thread Reported",
+		Color:     utils.Colors.Warning(),
+		Fields:    fields,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+// reportComponents returns the moderator action buttons for a report.
+func reportComponents(threadID string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{Label: "Dismiss", Style: discordgo.SecondaryButton, CustomID: dismissPrefix + threadID},
+			discordgo.Button{Label: "Delete thread", Style: discordgo.DangerButton, CustomID: deletePrefix + threadID},
+		}},
+	}
+}
+
+// modalTextValue finds a text input value by custom ID, handling both value
+// and pointer forms of ActionsRow.
+func modalTextValue(components []discordgo.MessageComponent, customID string) string {
+	for _, comp := range components {
+		var row *discordgo.ActionsRow
+		switch v := comp.(type) {
+		case discordgo.ActionsRow:
+			row = &v
+		case *discordgo.ActionsRow:
+			row = v
+		default:
+			continue
+		}
+		for _, inner := range row.Components {
+			if ti, ok := inner.(*discordgo.TextInput); ok && ti.CustomID == customID {
+				return ti.Value
+			}
+		}
+	}
+	return ""
+}
+
+// truncate shortens s to at most n characters. It cuts by rune, since cutting
+// by byte can split a character and Discord rejects invalid UTF-8.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	})
+}
@@ -0,0 +1,73 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddReportDedupesByThread(t *testing.T) {
+	m := &Module{reports: make(map[string]*threadReport)}
+	now := time.Now()
+	add := func(threadID, reporterID, reason string) (*threadReport, bool) {
+		rep := m.openReport(threadID, now)
+		isNew := addReporter(rep, reporterID, reason)
+		if isNew {
+			rep.LogMessageID = "M-" + threadID
+		}
+		return rep, isNew
+	}
+
+	rep, isNew := add("T1", "U1", "spam")
+	require.True(t, isNew)
+	require.Equal(t, []string{"U1"}, rep.Reporters)
+
+	// A second reporter joins the same entry
+	rep, isNew = add("T1", "U2", "")
+	require.False(t, isNew)
+	require.Equal(t, []string{"U1", "U2"}, rep.Reporters)
+	require.Len(t, rep.Reasons, 1)
+
+	// The same reporter again only adds their reason
+	rep, isNew = add("T1", "U1", "still spam")
+	require.False(t, isNew)
+	require.Equal(t, []string{"U1", "U2"}, rep.Reporters)
+	require.Equal(t, []string{"<@U1>: spam", "<@U1>: still spam"}, rep.Reasons)
+
+	// Other threads get their own entry
+	_, isNew = add("T2", "U1", "")
+	require.True(t, isNew)
+}
+
+func TestOpenReportLifecycle(t *testing.T) {
+	m := &Module{reports: make(map[string]*threadReport)}
+	now := time.Now()
+
+	t.Run("closed report is replaced", func(t *testing.T) {
+		rep := m.openReport("T1", now)
+		m.closeReport(rep)
+		require.True(t, m.isClosed(rep))
+		require.NotSame(t, rep, m.openReport("T1", now))
+	})
+
+	t.Run("idle reports expire", func(t *testing.T) {
+		stale := m.openReport("T2", now)
+		fresh := m.openReport("T3", now.Add(reportExpiry-time.Minute))
+		m.openReport("T4", now.Add(reportExpiry+reportPruneInterval))
+		require.True(t, m.isClosed(stale))
+		require.False(t, m.isClosed(fresh))
+		require.NotContains(t, m.reports, "T2")
+		require.Contains(t, m.reports, "T3")
+	})
+}
+
+func TestTruncateKeepsRunesWhole(t *testing.T) {
+	s := strings.Repeat("スパム", 500)
+	out := truncate(s, 1024)
+	require.True(t, utf8.ValidString(out))
+	require.Equal(t, 1024, utf8.RuneCountInString(out))
+	require.Equal(t, "short", truncate("short", 1024))
+}
@@ -0,0 +1,45 @@
+package say
+
+import (
+	"fmt"
+	"gamerpal/internal/utils"
+	"unicode/utf8"
+)
+
+// maxSayLength is Discord's cap on message content.
+const maxSayLength = 2000
+
+// modFooter is appended to say messages unless the moderator suppresses it.
+const modFooter = "\n\n**On behalf of moderator**"
+
+// withModFooter returns content as it will be sent, footer included.
+func withModFooter(content string, suppress bool) string {
+	if suppress {
+		return content
+	}
+	return content + modFooter
+}
+
+// sayChunks returns the messages needed to send content. Content over the
+// limit is rejected unless split is set, in which case it is broken up at
+// newline boundaries where possible.
+func sayChunks(content string, split bool) ([]string, error) {
+	n := utf8.RuneCountInString(content)
+	if n <= maxSayLength {
+		return []string{content}, nil
+	}
+	if !split {
+		return nil, fmt.Errorf("message is %d characters but Discord allows at most %d (including the moderator footer); shorten it or set `split` to send it as multiple messages", n, maxSayLength)
+	}
+	return utils.SplitMessage(content, maxSayLength), nil
+}
+
+// contentPreview truncates content for display in confirmation embeds, whose
+// fields are capped well below the message limit.
+func contentPreview(content string, maxRunes int) string {
+	runes := []rune(content)
+	if len(runes) <= maxRunes {
+		return content
+	}
+	return string(runes[:maxRunes]) + "…"
+}
@@ -0,0 +1,38 @@
+package say
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSayChunks(t *testing.T) {
+	t.Run("fits in one message", func(t *testing.T) {
+		chunks, err := sayChunks(withModFooter("hello", false), false)
+		require.NoError(t, err)
+		require.Equal(t, []string{"hello" + modFooter}, chunks)
+	})
+
+	t.Run("footer counts toward the limit", func(t *testing.T) {
+		body := strings.Repeat("a", maxSayLength-5)
+		_, err := sayChunks(withModFooter(body, true), false)
+		require.NoError(t, err)
+
+		_, err = sayChunks(withModFooter(body, false), false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "2000")
+		require.Contains(t, err.Error(), "2023")
+	})
+
+	t.Run("split breaks long content into valid messages", func(t *testing.T) {
+		body := strings.Repeat(strings.Repeat("b", 99)+"\n", 30)
+		chunks, err := sayChunks(withModFooter(body, false), true)
+		require.NoError(t, err)
+		require.Len(t, chunks, 2)
+		for _, c := range chunks {
+			require.LessOrEqual(t, len([]rune(c)), maxSayLength)
+		}
+		require.Equal(t, body+modFooter, strings.Join(chunks, ""))
+	})
+}
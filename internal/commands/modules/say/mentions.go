@@ -0,0 +1,27 @@
+package say
+
+import "github.com/bwmarrin/discordgo"
+
+// sayAllowedMentions controls which mentions in a say message actually ping.
+// By default nothing pings, so a pasted @everyone, @here, or role mention is
+// rendered as text only. Moderators opt in with the allow_pings option.
+func sayAllowedMentions(allowPings bool) *discordgo.MessageAllowedMentions {
+	if !allowPings {
+		return &discordgo.MessageAllowedMentions{Parse: []discordgo.AllowedMentionType{}}
+	}
+	return &discordgo.MessageAllowedMentions{
+		Parse: []discordgo.AllowedMentionType{
+			discordgo.AllowedMentionTypeEveryone,
+			discordgo.AllowedMentionTypeRoles,
+			discordgo.AllowedMentionTypeUsers,
+		},
+	}
+}
+
+// sayMessage builds the outgoing message for say commands.
+func sayMessage(content string, allowPings bool) *discordgo.MessageSend {
+	return &discordgo.MessageSend{
+		Content:         content,
+		AllowedMentions: sayAllowedMentions(allowPings),
+	}
+}
@@ -0,0 +1,34 @@
+package say
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSayMessageAllowedMentions(t *testing.T) {
+	t.Run("suppresses all pings by default", func(t *testing.T) {
+		msg := sayMessage("hey @everyone <@&123>", false)
+		require.NotNil(t, msg.AllowedMentions)
+		require.Empty(t, msg.AllowedMentions.Parse)
+		require.Empty(t, msg.AllowedMentions.Roles)
+		require.Empty(t, msg.AllowedMentions.Users)
+
+		// An empty parse list must still be sent, otherwise Discord falls
+		// back to its default of pinging everything.
+		raw, err := json.Marshal(msg.AllowedMentions)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"parse":[],"replied_user":false}`, string(raw))
+	})
+
+	t.Run("allow_pings enables everyone, roles and users", func(t *testing.T) {
+		msg := sayMessage("hey @everyone", true)
+		require.ElementsMatch(t, []discordgo.AllowedMentionType{
+			discordgo.AllowedMentionTypeEveryone,
+			discordgo.AllowedMentionTypeRoles,
+			discordgo.AllowedMentionTypeUsers,
+		}, msg.AllowedMentions.Parse)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
 	"gamerpal/internal/utils"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,7 +23,7 @@ type Module struct {
 func New(deps *types.Dependencies) *Module {
 	return &Module{
 		config:  deps.Config,
-		service: NewService(deps.Config),
+		service: NewService(deps.Config, deps.DB),
 	}
 }
 
@@ -56,6 +57,18 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 					Description: "If true, suppress 'On behalf of moderator' footer",
 					Required:    false,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "allow_pings",
+					Description: "If true, @everyone, @here, role and user mentions will ping (default: false)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "split",
+					Description: "If true, messages over 2000 characters are sent as multiple messages",
+					Required:    false,
+				},
 			},
 		},
 		HandlerFunc: m.handleSay,
@@ -85,7 +98,13 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 					Type:        discordgo.ApplicationCommandOptionInteger,
 					Name:        "timestamp",
 					Description: "Unix timestamp when to send (use any converter or <t:> preview)",
-					Required:    true,
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "time",
+					Description: "Or a time like 2025-06-01 15:00 or 3pm, in your /timezone (UTC if unset)",
+					Required:    false,
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionBoolean,
@@ -93,6 +112,18 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 					Description: "If true, suppress 'On behalf of moderator' footer",
 					Required:    false,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "allow_pings",
+					Description: "If true, @everyone, @here, role and user mentions will ping (default: false)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "split",
+					Description: "If true, messages over 2000 characters are sent as multiple messages",
+					Required:    false,
+				},
 			},
 		},
 		HandlerFunc: m.handleScheduleSay,
@@ -105,6 +136,15 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 			Description:              "List upcoming scheduled messages",
 			DefaultMemberPermissions: &modPerms,
 			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "page",
+					Description: "Page to show (default 1)",
+					Required:    false,
+					MinValue:    new(1.0),
+				},
+			},
 		},
 		HandlerFunc: m.handleListScheduledSays,
 	}
@@ -128,6 +168,49 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 		HandlerFunc: m.handleCancelScheduledSay,
 	}
 
+	// Register /editscheduledsay command
+	cmds["editscheduledsay"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "editscheduledsay",
+			Description:              "Change a scheduled message before it is sent",
+			DefaultMemberPermissions: &modPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "id",
+					Description: "The ID of the scheduled message to edit",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "message",
+					Description: "New message content",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionChannel,
+					Name:        "channel",
+					Description: "New channel to send the message to",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "timestamp",
+					Description: "New Unix timestamp when to send",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "time",
+					Description: "Or a new time like 2025-06-01 15:00 or 3pm, in your /timezone (UTC if unset)",
+					Required:    false,
+				},
+			},
+		},
+		HandlerFunc: m.handleEditScheduledSay,
+	}
+
 	cmds["directsay"] = &types.Command{
 		ApplicationCommand: &discordgo.ApplicationCommand{
 			Name:                     "directsay",
@@ -135,17 +218,29 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 			DefaultMemberPermissions: &modPerms,
 			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
 			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "message",
+					Description: "The message content",
+					Required:    true,
+				},
 				{
 					Type:        discordgo.ApplicationCommandOptionUser,
 					Name:        "user",
 					Description: "The user to send the DM to",
-					Required:    true,
+					Required:    false,
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "message",
-					Description: "The message content",
-					Required:    true,
+					Name:        "user_id",
+					Description: "User ID to DM instead, for users no longer in the server",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "allow_pings",
+					Description: "If true, @everyone, @here, role and user mentions will ping (default: false)",
+					Required:    false,
 				},
 			},
 		},
@@ -175,6 +270,8 @@ func (m *Module) handleSay(s *discordgo.Session, i *discordgo.InteractionCreate)
 	var targetChannelID string
 	var messageContent string
 	var suppressModMessage bool
+	var allowPings bool
+	var split bool
 
 	for _, option := range options {
 		switch option.Name {
@@ -184,6 +281,10 @@ func (m *Module) handleSay(s *discordgo.Session, i *discordgo.InteractionCreate)
 			messageContent = option.StringValue()
 		case "suppressmodmessage":
 			suppressModMessage = option.BoolValue()
+		case "allow_pings":
+			allowPings = option.BoolValue()
+		case "split":
+			split = option.BoolValue()
 		}
 	}
 
@@ -236,23 +337,38 @@ func (m *Module) handleSay(s *discordgo.Session, i *discordgo.InteractionCreate)
 		return
 	}
 
-	if !suppressModMessage {
-		messageContent = fmt.Sprintf("%s\n\n**On behalf of moderator**", messageContent)
-	}
-
-	// Send the message to the target channel
-	sentMessage, err := s.ChannelMessageSend(targetChannelID, messageContent)
+	messageContent = withModFooter(messageContent, suppressModMessage)
+	chunks, err := sayChunks(messageContent, split)
 	if err != nil {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: fmt.Sprintf("❌ Failed to send message to %s: %v", targetChannel.Mention(), err),
+				Content: fmt.Sprintf("❌ Your %s", err),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
+	// Send the message to the target channel
+	var sentMessage *discordgo.Message
+	for idx, chunk := range chunks {
+		sent, err := s.ChannelMessageSendComplex(targetChannelID, sayMessage(chunk, allowPings))
+		if err != nil {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("❌ Failed to send message part %d/%d to %s: %v", idx+1, len(chunks), targetChannel.Mention(), err),
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			})
+			return
+		}
+		if sentMessage == nil {
+			sentMessage = sent
+		}
+	}
+
 	// Create confirmation embed for the admin
 	embed := &discordgo.MessageEmbed{
 		Title:       "✅ Message Sent Successfully",
@@ -269,9 +385,14 @@ func (m *Module) handleSay(s *discordgo.Session, i *discordgo.InteractionCreate)
 				Value:  sentMessage.ID,
 				Inline: true,
 			},
+			{
+				Name:   "Parts",
+				Value:  fmt.Sprintf("%d", len(chunks)),
+				Inline: true,
+			},
 			{
 				Name:   "Message Content",
-				Value:  fmt.Sprintf("```%s```", messageContent),
+				Value:  fmt.Sprintf("```%s```", contentPreview(messageContent, 1000)),
 				Inline: false,
 			},
 		},
@@ -293,7 +414,10 @@ func (m *Module) handleScheduleSay(s *discordgo.Session, i *discordgo.Interactio
 	var channelID string
 	var messageContent string
 	var timestampVal int64
+	var localTime string
 	var suppressModMessage bool
+	var allowPings bool
+	var split bool
 
 	for _, opt := range options {
 		switch opt.Name {
@@ -303,43 +427,48 @@ func (m *Module) handleScheduleSay(s *discordgo.Session, i *discordgo.Interactio
 			messageContent = opt.StringValue()
 		case "timestamp":
 			timestampVal = opt.IntValue()
+		case "time":
+			localTime = opt.StringValue()
 		case "suppressmodmessage":
 			suppressModMessage = opt.BoolValue()
+		case "allow_pings":
+			allowPings = opt.BoolValue()
+		case "split":
+			split = opt.BoolValue()
 		}
 	}
 
-	if channelID == "" || messageContent == "" || timestampVal == 0 {
+	if channelID == "" || messageContent == "" || (timestampVal == 0 && localTime == "") {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ Missing required parameters.", Flags: discordgo.MessageFlagsEphemeral}})
 		return
 	}
 
-	fireAt := time.Unix(timestampVal, 0)
-	if fireAt.Before(time.Now().Add(30 * time.Second)) { // require at least 30s lead
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ Timestamp must be at least 30 seconds in the future.", Flags: discordgo.MessageFlagsEphemeral}})
-		return
-	}
-
-	// verify channel
-	ch, err := s.Channel(channelID)
+	fireAt, zoneNote, err := m.resolveFireAt(i.Member.User.ID, timestampVal, localTime)
 	if err != nil {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ Unable to access the specified channel.", Flags: discordgo.MessageFlagsEphemeral}})
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ " + err.Error(), Flags: discordgo.MessageFlagsEphemeral}})
 		return
 	}
-	perms, err := s.UserChannelPermissions(s.State.User.ID, channelID)
-	if err != nil || perms&discordgo.PermissionSendMessages == 0 {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: fmt.Sprintf("❌ I don't have permission to send messages in %s.", ch.Mention()), Flags: discordgo.MessageFlagsEphemeral}})
+	timestampVal = fireAt.Unix()
+	ch, problem := validateScheduledSay(s, channelID, messageContent, fireAt, suppressModMessage, split)
+	if problem != "" {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: problem, Flags: discordgo.MessageFlagsEphemeral}})
 		return
 	}
 
 	// store scheduled message
-	id := m.service.Add(ScheduledMessage{ChannelID: channelID, Content: messageContent, FireAt: fireAt, ScheduledBy: i.Member.User.ID, SuppressModMessage: suppressModMessage})
+	id, err := m.service.Add(ScheduledMessage{ChannelID: channelID, Content: messageContent, FireAt: fireAt, ScheduledBy: i.Member.User.ID, SuppressModMessage: suppressModMessage, AllowPings: allowPings, Split: split})
+	if err != nil {
+		m.service.cfg.Logger.Errorf("failed to store scheduled say: %v", err)
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "❌ Failed to save the scheduled message. Please try again.", Flags: discordgo.MessageFlagsEphemeral}})
+		return
+	}
 
 	// log scheduling
 	preview := messageContent
 	if len(preview) > 10 {
 		preview = preview[:10]
 	}
-	logMsg := fmt.Sprintf("[ScheduledSay Added]\nID: %d\nChannel: %s (%s)\nModerator: %s (%s)\nFire At: %s (<t:%d:F>)\nSuppress Footer: %v\nLength: %d\nPreview: %.10q", id, ch.Mention(), ch.ID, i.Member.User.String(), i.Member.User.ID, fireAt.UTC().Format(time.RFC3339), fireAt.Unix(), suppressModMessage, len(messageContent), preview)
+	logMsg := fmt.Sprintf("[ScheduledSay Added]\nID: %d\nChannel: %s (%s)\nModerator: %s (%s)\nFire At: %s (<t:%d:F>)\nSuppress Footer: %v\nAllow Pings: %v\nLength: %d\nPreview: %.10q", id, ch.Mention(), ch.ID, i.Member.User.String(), i.Member.User.ID, fireAt.UTC().Format(time.RFC3339), fireAt.Unix(), suppressModMessage, allowPings, len(messageContent), preview)
 	if lErr := utils.LogToChannel(m.service.cfg, s, logMsg); lErr != nil {
 		m.service.cfg.Logger.Errorf("failed logging schedule creation: %v", lErr)
 	}
@@ -351,12 +480,13 @@ func (m *Module) handleScheduleSay(s *discordgo.Session, i *discordgo.Interactio
 	}
 	embed := &discordgo.MessageEmbed{
 		Title:       "✅ Message Scheduled",
-		Description: fmt.Sprintf("ID %d scheduled for %s at <t:%d:F> (<t:%d:R>) %s", id, ch.Mention(), timestampVal, timestampVal, footer),
+		Description: fmt.Sprintf("ID %d scheduled for %s at <t:%d:F> (<t:%d:R>) %s%s", id, ch.Mention(), timestampVal, timestampVal, footer, zoneNote),
 		Color:       utils.Colors.Info(),
 		Fields: []*discordgo.MessageEmbedField{
 			{Name: "Channel", Value: ch.Mention(), Inline: true},
 			{Name: "Fire Time", Value: fmt.Sprintf("<t:%d:F>", timestampVal), Inline: true},
 			{Name: "Suppress Mod Msg", Value: fmt.Sprintf("%v", suppressModMessage), Inline: true},
+			{Name: "Allow Pings", Value: fmt.Sprintf("%v", allowPings), Inline: true},
 			{Name: "Content (truncated preview)", Value: fmt.Sprintf("```%s```", strings.ReplaceAll(messageContent[:min(200, len(messageContent))], "`", "'")), Inline: false},
 		},
 	}
@@ -364,15 +494,200 @@ func (m *Module) handleScheduleSay(s *discordgo.Session, i *discordgo.Interactio
 	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Flags: discordgo.MessageFlagsEphemeral}})
 }
 
-// handleListScheduledSays lists up to the next 20 scheduled messages
+// scheduledSaysPerPage is how many scheduled says one list page shows; each
+// is an embed field, so this stays well under Discord's 25-field cap.
+const scheduledSaysPerPage = 10
+
+// sayListPagePrefix prefixes the list's previous/next button custom IDs.
+const sayListPagePrefix = "say:list:"
+
+// resolveFireAt turns the timestamp or time option into a fire time. A typed
+// time is read in the user's /timezone, or UTC when none is set; the returned
+// note names the zone used so the confirmation can show it.
+func (m *Module) resolveFireAt(userID string, timestamp int64, local string) (time.Time, string, error) {
+	switch {
+	case timestamp != 0 && local != "":
+		return time.Time{}, "", fmt.Errorf("use either timestamp or time, not both")
+	case timestamp != 0:
+		return time.Unix(timestamp, 0), "", nil
+	}
+	loc := time.UTC
+	if m.service.db != nil {
+		var err error
+		if loc, err = m.service.db.GetUserTimezone(userID); err != nil {
+			m.config.Logger.Warnf("Failed to load timezone for %s, using UTC: %v", userID, err)
+		}
+	}
+	fireAt, err := utils.ParseLocalTime(local, loc, time.Now())
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return fireAt, fmt.Sprintf(" (read as %s time; change with /timezone set)", loc), nil
+}
+
+// validateScheduledSay runs the checks a scheduled say must pass: the content
+// fits (or splits), fireAt is at least 30 seconds out, and the bot can post in
+// the channel. It returns the channel, or a user-facing error message.
+func validateScheduledSay(s *discordgo.Session, channelID, content string, fireAt time.Time, suppressModMessage, split bool) (*discordgo.Channel, string) {
+	// Validate now rather than failing silently when the message fires.
+	if _, err := sayChunks(withModFooter(content, suppressModMessage), split); err != nil {
+		return nil, fmt.Sprintf("❌ Your %s", err)
+	}
+	if fireAt.Before(time.Now().Add(30 * time.Second)) { // require at least 30s lead
+		return nil, "❌ Timestamp must be at least 30 seconds in the future."
+	}
+	ch, err := s.Channel(channelID)
+	if err != nil {
+		return nil, "❌ Unable to access the specified channel."
+	}
+	perms, err := s.UserChannelPermissions(s.State.User.ID, channelID)
+	if err != nil || perms&discordgo.PermissionSendMessages == 0 {
+		return nil, fmt.Sprintf("❌ I don't have permission to send messages in %s.", ch.Mention())
+	}
+	return ch, ""
+}
+
+// handleEditScheduledSay changes the message, channel or time of a queued
+// scheduled say, keeping its ID. Edits are validated like /schedulesay.
+func (m *Module) handleEditScheduledSay(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral}})
+	}
+
+	var idVal, timestampVal int64
+	var channelID, messageContent, localTime string
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "id":
+			idVal = opt.IntValue()
+		case "message":
+			messageContent = opt.StringValue()
+		case "channel":
+			channelID = opt.ChannelValue(s).ID
+		case "timestamp":
+			timestampVal = opt.IntValue()
+		case "time":
+			localTime = opt.StringValue()
+		}
+	}
+	if idVal == 0 {
+		respond("Missing or invalid ID.")
+		return
+	}
+	if messageContent == "" && channelID == "" && timestampVal == 0 && localTime == "" {
+		respond("❌ Provide a new message, channel or time to change.")
+		return
+	}
+	var zoneNote string
+	if timestampVal != 0 || localTime != "" {
+		fireAt, note, err := m.resolveFireAt(i.Member.User.ID, timestampVal, localTime)
+		if err != nil {
+			respond("❌ " + err.Error())
+			return
+		}
+		timestampVal, zoneNote = fireAt.Unix(), note
+	}
+
+	current, ok := m.service.Get(idVal)
+	if !ok {
+		respond(fmt.Sprintf("No scheduled say with ID %d found (it may have already been sent or cancelled)", idVal))
+		return
+	}
+
+	next := current
+	var changes []string
+	if messageContent != "" && messageContent != current.Content {
+		next.Content = messageContent
+		changes = append(changes, fmt.Sprintf("Message: %d → %d chars", len(current.Content), len(messageContent)))
+	}
+	if channelID != "" && channelID != current.ChannelID {
+		next.ChannelID = channelID
+		changes = append(changes, fmt.Sprintf("Channel: <#%s> → <#%s>", current.ChannelID, channelID))
+	}
+	if timestampVal != 0 && timestampVal != current.FireAt.Unix() {
+		next.FireAt = time.Unix(timestampVal, 0)
+		changes = append(changes, fmt.Sprintf("Fire At: <t:%d:F> → <t:%d:F>", current.FireAt.Unix(), timestampVal))
+	}
+	if len(changes) == 0 {
+		respond(fmt.Sprintf("Scheduled say %d already has those values.", idVal))
+		return
+	}
+
+	ch, problem := validateScheduledSay(s, next.ChannelID, next.Content, next.FireAt, next.SuppressModMessage, next.Split)
+	if problem != "" {
+		respond(problem)
+		return
+	}
+
+	updated, ok, err := m.service.Edit(idVal, next.ChannelID, next.Content, next.FireAt)
+	if err != nil {
+		m.service.cfg.Logger.Errorf("failed to update scheduled say %d: %v", idVal, err)
+		respond("❌ Failed to save the change. Please try again.")
+		return
+	}
+	if !ok {
+		respond(fmt.Sprintf("No scheduled say with ID %d found (it may have already been sent or cancelled)", idVal))
+		return
+	}
+
+	logMsg := fmt.Sprintf("[ScheduledSay Edited]\nID: %d\nModerator: %s (%s)\n%s\nPreview: %.10q", updated.ID, i.Member.User.String(), i.Member.User.ID, strings.Join(changes, "\n"), updated.Content)
+	if lErr := utils.LogToChannel(m.service.cfg, s, logMsg); lErr != nil {
+		m.service.cfg.Logger.Errorf("failed logging schedule edit: %v", lErr)
+	}
+	m.service.cfg.Logger.Info(logMsg)
+
+	fireUnix := updated.FireAt.Unix()
+	embed := &discordgo.MessageEmbed{
+		Title:       "✅ Scheduled Message Updated",
+		Description: fmt.Sprintf("ID %d now sends in %s at <t:%d:F> (<t:%d:R>)%s\n\n%s", updated.ID, ch.Mention(), fireUnix, fireUnix, zoneNote, strings.Join(changes, "\n")),
+		Color:       utils.Colors.Info(),
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Channel", Value: ch.Mention(), Inline: true},
+			{Name: "Fire Time", Value: fmt.Sprintf("<t:%d:F>", fireUnix), Inline: true},
+			{Name: "Content (truncated preview)", Value: fmt.Sprintf("```%s```", strings.ReplaceAll(updated.Content[:min(200, len(updated.Content))], "`", "'")), Inline: false},
+		},
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Flags: discordgo.MessageFlagsEphemeral}})
+}
+
+// handleListScheduledSays lists one page of upcoming scheduled messages
 func (m *Module) handleListScheduledSays(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	list := m.service.List(20)
-	if len(list) == 0 {
+	page := 1
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "page" {
+			page = int(opt.IntValue())
+		}
+	}
+	embed, components, ok := m.scheduledSaysPage(page)
+	if !ok {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Content: "No scheduled messages.", Flags: discordgo.MessageFlagsEphemeral}})
 		return
 	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Components: components, Flags: discordgo.MessageFlagsEphemeral}})
+}
+
+// HandleComponent handles the previous/next buttons on /listscheduledsays.
+func (m *Module) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	page, err := strconv.Atoi(strings.TrimPrefix(i.MessageComponentData().CustomID, sayListPagePrefix))
+	if err != nil {
+		return
+	}
+	embed, components, ok := m.scheduledSaysPage(page)
+	data := &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Components: components}
+	if !ok {
+		data = &discordgo.InteractionResponseData{Content: "No scheduled messages.", Embeds: []*discordgo.MessageEmbed{}, Components: []discordgo.MessageComponent{}}
+	}
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseUpdateMessage, Data: data})
+}
+
+// scheduledSaysPage renders a 1-based page of the queue, clamped to the last
+// page, with previous/next buttons. ok is false when nothing is queued.
+func (m *Module) scheduledSaysPage(page int) (*discordgo.MessageEmbed, []discordgo.MessageComponent, bool) {
+	list, page, pages, total := m.service.Page(page, scheduledSaysPerPage)
+	if total == 0 {
+		return nil, nil, false
+	}
 
-	// Build fields; ensure we don't exceed embed field limits (25) - we cap at 20 anyway.
 	fields := make([]*discordgo.MessageEmbedField, 0, len(list))
 	for _, msg := range list {
 		preview := msg.Content
@@ -394,14 +709,23 @@ func (m *Module) handleListScheduledSays(s *discordgo.Session, i *discordgo.Inte
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title:       "Scheduled Says (next 20)",
-		Description: fmt.Sprintf("Total queued (showing up to 20): %d", len(list)),
+		Title:       "Scheduled Says",
+		Description: fmt.Sprintf("Total queued: %d • Page %d of %d", total, page, pages),
 		Color:       utils.Colors.Info(),
 		Fields:      fields,
 		Footer:      &discordgo.MessageEmbedFooter{Text: "Use /cancelscheduledsay <ID> to cancel"},
 	}
 
-	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseChannelMessageWithSource, Data: &discordgo.InteractionResponseData{Embeds: []*discordgo.MessageEmbed{embed}, Flags: discordgo.MessageFlagsEphemeral}})
+	var components []discordgo.MessageComponent
+	if pages > 1 {
+		components = []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				&discordgo.Button{Style: discordgo.SecondaryButton, Label: "Previous", CustomID: fmt.Sprintf("%s%d", sayListPagePrefix, page-1), Disabled: page == 1},
+				&discordgo.Button{Style: discordgo.SecondaryButton, Label: "Next", CustomID: fmt.Sprintf("%s%d", sayListPagePrefix, page+1), Disabled: page == pages},
+			}},
+		}
+	}
+	return embed, components, true
 }
 
 // handleCancelScheduledSay cancels a scheduled message by ID
@@ -426,27 +750,62 @@ func (m *Module) handleCancelScheduledSay(s *discordgo.Session, i *discordgo.Int
 func (m *Module) handleDirectSay(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	// Parse command options
 	options := i.ApplicationCommandData().Options
-	if len(options) < 2 {
-		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{
-				Content: "❌ Missing required parameters. Please specify both user and message.",
-				Flags:   discordgo.MessageFlagsEphemeral,
-			},
-		})
-		return
-	}
-	// Get user ID and message from arguments
+	// Get user and message from arguments
 	var targetUser *discordgo.User
+	var rawUserID string
 	var messageContent string
+	var allowPings bool
 
 	for _, option := range options {
 		switch option.Name {
 		case "user":
 			targetUser = option.UserValue(s)
+		case "user_id":
+			rawUserID = option.StringValue()
 		case "message":
 			messageContent = option.StringValue()
+		case "allow_pings":
+			allowPings = option.BoolValue()
+		}
+	}
+
+	if targetUser == nil && rawUserID == "" {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "❌ Missing required parameters. Please specify a user or user_id and a message.",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		return
+	}
+
+	// The user option wins when both are given; user_id is for people who
+	// are no longer in the server and so can't be picked from the list.
+	if targetUser == nil {
+		userID, ok := parseUserID(rawUserID)
+		if !ok {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("❌ `%s` is not a valid Discord user ID.", rawUserID),
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			})
+			return
 		}
+		user, err := s.User(userID)
+		if err != nil {
+			_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("❌ Could not find a Discord user with ID `%s`.", userID),
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			})
+			return
+		}
+		targetUser = user
 	}
 
 	targetUserChannel, err := s.UserChannelCreate(targetUser.ID)
@@ -454,7 +813,7 @@ func (m *Module) handleDirectSay(s *discordgo.Session, i *discordgo.InteractionC
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: fmt.Sprintf("❌ Could not DM %s. They may have DMs disabled.", targetUser.Username),
+				Content: fmt.Sprintf("❌ Could not DM %s. They may have DMs disabled or share no server with the bot.", targetUser.Username),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -465,15 +824,16 @@ func (m *Module) handleDirectSay(s *discordgo.Session, i *discordgo.InteractionC
 	messageContent = fmt.Sprintf("**On behalf of a GamerPals Moderator:**\n\n%s\n\n**Do not reply to this message, replies are not monitored.**", messageContent)
 	messageContent = fmt.Sprintf("%s\n\n**If you need any assistance, please visit the GamerPals <#%s> channel and open a ticket.**", messageContent, helpDeskID)
 
-	sentMessage, err := s.ChannelMessageSend(targetUserChannel.ID, messageContent)
+	sentMessage, err := s.ChannelMessageSendComplex(targetUserChannel.ID, sayMessage(messageContent, allowPings))
 	if err != nil {
 		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: fmt.Sprintf("❌ Failed to send DM to %s.", targetUser.Username),
+				Content: fmt.Sprintf("❌ Failed to send DM to %s. They may have DMs disabled or share no server with the bot.", targetUser.Username),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
+		return
 	}
 
 	// Create confirmation embed for the admin
@@ -529,3 +889,21 @@ func (m *Module) handleDirectSay(s *discordgo.Session, i *discordgo.InteractionC
 		m.service.cfg.Logger.Errorf("failed logging direct say: %v", err)
 	}
 }
+
+// parseUserID accepts a raw user ID or a mention (<@id>, <@!id>) and reports
+// whether it looks like a Discord snowflake.
+func parseUserID(raw string) (string, bool) {
+	id := strings.TrimSpace(raw)
+	id = strings.TrimPrefix(id, "<@")
+	id = strings.TrimPrefix(id, "!")
+	id = strings.TrimSuffix(id, ">")
+	if len(id) < 17 || len(id) > 20 {
+		return "", false
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	return id, true
+}
@@ -0,0 +1,28 @@
+package say
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserID(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"123456789012345678", "123456789012345678", true},
+		{" 123456789012345678 ", "123456789012345678", true},
+		{"<@123456789012345678>", "123456789012345678", true},
+		{"<@!123456789012345678>", "123456789012345678", true},
+		{"12345", "", false},
+		{"12345678901234567a", "", false},
+		{"someuser", "", false},
+		{"", "", false},
+	} {
+		got, ok := parseUserID(tc.in)
+		require.Equal(t, tc.ok, ok, tc.in)
+		require.Equal(t, tc.want, got, tc.in)
+	}
+}
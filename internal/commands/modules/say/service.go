@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"gamerpal/internal/commands/types"
 	"gamerpal/internal/config"
+	"gamerpal/internal/database"
 	"gamerpal/internal/utils"
 	"sort"
 	"sync"
@@ -21,20 +22,25 @@ type ScheduledMessage struct {
 	FireAt             time.Time
 	ScheduledBy        string // user ID of moderator
 	SuppressModMessage bool
+	AllowPings         bool
+	Split              bool
 }
 
-// Service holds scheduled messages in memory only
+// Service holds scheduled messages in memory while running. Every add, edit,
+// cancel and send is written through to the database, which is reloaded when
+// the session is hydrated, so queued messages and their IDs survive a crash.
 type Service struct {
 	types.BaseService
 	cfg      *config.Config
+	db       *database.DB
 	mu       sync.Mutex
 	messages []ScheduledMessage
 	nextID   atomic.Int64
 }
 
 // NewService creates a new say service
-func NewService(cfg *config.Config) *Service {
-	svc := &Service{cfg: cfg, messages: make([]ScheduledMessage, 0, 16)}
+func NewService(cfg *config.Config, db *database.DB) *Service {
+	svc := &Service{cfg: cfg, db: db, messages: make([]ScheduledMessage, 0, 16)}
 	svc.nextID.Store(1)
 	return svc
 }
@@ -44,40 +50,160 @@ func (s *Service) SetSession(session *discordgo.Session) {
 	s.Session = session
 }
 
-// Add inserts a new scheduled message (kept in-memory only)
-func (s *Service) Add(msg ScheduledMessage) int64 {
-	msg.ID = s.nextID.Add(1) - 1
+// HydrateServiceDiscordSession stores the session and reloads the persisted
+// queue. Messages whose time passed while the bot was down fire on the next
+// check.
+func (s *Service) HydrateServiceDiscordSession(session *discordgo.Session) error {
+	s.Session = session
+	if s.db == nil {
+		return nil
+	}
+	saved, err := s.db.ListScheduledSays()
+	if err != nil {
+		return fmt.Errorf("failed to restore scheduled says: %w", err)
+	}
+	s.mu.Lock()
+	for _, say := range saved {
+		s.messages = append(s.messages, ScheduledMessage{
+			ID:                 say.ID,
+			ChannelID:          say.ChannelID,
+			Content:            say.Content,
+			FireAt:             say.FireAt,
+			ScheduledBy:        say.ScheduledBy,
+			SuppressModMessage: say.SuppressModMessage,
+			AllowPings:         say.AllowPings,
+			Split:              say.Split,
+		})
+	}
+	sort.SliceStable(s.messages, func(i, j int) bool { return s.messages[i].FireAt.Before(s.messages[j].FireAt) })
+	s.mu.Unlock()
+	if len(saved) > 0 {
+		s.cfg.Logger.Infof("Restored %d scheduled say message(s)", len(saved))
+	}
+	return nil
+}
+
+// Add queues a new scheduled message and returns its ID. With a database the
+// message is stored first and takes its row ID.
+func (s *Service) Add(msg ScheduledMessage) (int64, error) {
+	if s.db != nil {
+		id, err := s.db.InsertScheduledSay(database.ScheduledSay{
+			ChannelID:          msg.ChannelID,
+			Content:            msg.Content,
+			FireAt:             msg.FireAt,
+			ScheduledBy:        msg.ScheduledBy,
+			SuppressModMessage: msg.SuppressModMessage,
+			AllowPings:         msg.AllowPings,
+			Split:              msg.Split,
+		})
+		if err != nil {
+			return 0, err
+		}
+		msg.ID = id
+	} else {
+		msg.ID = s.nextID.Add(1) - 1
+	}
 	s.mu.Lock()
 	s.messages = append(s.messages, msg)
 	// keep slice ordered by FireAt ascending for efficient checks
 	sort.SliceStable(s.messages, func(i, j int) bool { return s.messages[i].FireAt.Before(s.messages[j].FireAt) })
 	s.mu.Unlock()
-	return msg.ID
+	return msg.ID, nil
+}
+
+// forget deletes a fired or cancelled message's row. Failures are logged; the
+// worst case is the message being restored after a restart.
+func (s *Service) forget(id int64) {
+	if s.db == nil {
+		return
+	}
+	if err := s.db.DeleteScheduledSay(id); err != nil {
+		s.cfg.Logger.Warnf("Scheduled say %d left in database: %v", id, err)
+	}
 }
 
-// List returns up to limit upcoming scheduled messages (sorted soonest first)
-func (s *Service) List(limit int) []ScheduledMessage {
+// Pending returns how many scheduled messages are waiting to be sent.
+func (s *Service) Pending() int {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if limit > len(s.messages) {
-		limit = len(s.messages)
+	return len(s.messages)
+}
+
+// Page returns one 1-based page of upcoming scheduled messages (sorted
+// soonest first), clamped to the last page, along with the clamped page
+// number, the page count and the total queued. Everything comes from one
+// snapshot, so the counts always match the messages. Fired and cancelled
+// messages leave the queue, so they are never listed.
+func (s *Service) Page(page, perPage int) (msgs []ScheduledMessage, current, pages, total int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	total = len(s.messages)
+	if total == 0 || perPage <= 0 {
+		return nil, 0, 0, total
 	}
-	out := make([]ScheduledMessage, limit)
-	copy(out, s.messages[:limit])
-	return out
+	pages = (total + perPage - 1) / perPage
+	current = min(max(page, 1), pages)
+	offset := (current - 1) * perPage
+	end := min(offset+perPage, total)
+	msgs = make([]ScheduledMessage, end-offset)
+	copy(msgs, s.messages[offset:end])
+	return msgs, current, pages, total
+}
+
+// Get returns the queued message with the given ID.
+func (s *Service) Get(id int64) (ScheduledMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, m := range s.messages {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return ScheduledMessage{}, false
+}
+
+// Edit replaces a queued message's channel, content and fire time, keeping
+// its ID and other settings. It returns false if the message already fired or
+// was cancelled.
+func (s *Service) Edit(id int64, channelID, content string, fireAt time.Time) (ScheduledMessage, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for idx := range s.messages {
+		if s.messages[idx].ID != id {
+			continue
+		}
+		if s.db != nil {
+			err := s.db.UpdateScheduledSay(database.ScheduledSay{ID: id, ChannelID: channelID, Content: content, FireAt: fireAt})
+			if err != nil {
+				return ScheduledMessage{}, true, err
+			}
+		}
+		s.messages[idx].ChannelID = channelID
+		s.messages[idx].Content = content
+		s.messages[idx].FireAt = fireAt
+		updated := s.messages[idx]
+		sort.SliceStable(s.messages, func(i, j int) bool { return s.messages[i].FireAt.Before(s.messages[j].FireAt) })
+		return updated, true, nil
+	}
+	return ScheduledMessage{}, false, nil
 }
 
 // Cancel removes a scheduled message by ID; returns true if removed
 func (s *Service) Cancel(id int64) bool {
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	found := false
 	for idx, m := range s.messages {
 		if m.ID == id {
 			s.messages = append(s.messages[:idx], s.messages[idx+1:]...)
-			return true
+			found = true
+			break
 		}
 	}
-	return false
+	s.mu.Unlock()
+	if found {
+		s.forget(id)
+	}
+	return found
 }
 
 // CheckAndSendDue sends all messages whose FireAt <= now.
@@ -105,13 +231,12 @@ func (s *Service) CheckAndSendDue(session *discordgo.Session) error {
 
 	var errs []error
 	for _, m := range due {
-		content := m.Content
-		if !m.SuppressModMessage {
-			content = fmt.Sprintf("%s\n\n**On behalf of moderator**", content)
-		}
-		sent, err := session.ChannelMessageSend(m.ChannelID, content)
+		sent, err := sendScheduled(session, m)
+		// The row goes once the send has been attempted, so a crash mid-send
+		// resends rather than drops. Failed sends are not retried.
+		s.forget(m.ID)
 		if err != nil {
-			errs = append(errs, fmt.Errorf("failed sending scheduled message to channel %s: %w", m.ChannelID, err))
+			errs = append(errs, err)
 			continue
 		}
 		logMsg := fmt.Sprintf("[ScheduledSay Fired]\nID: %d\nChannel: %s\nModerator: %s\nFire At: %s (<t:%d:F>)\nDiscord Msg ID: %s\nSuppress Footer: %v\nPreview: %.10q", m.ID, m.ChannelID, m.ScheduledBy, m.FireAt.UTC().Format(time.RFC3339), m.FireAt.Unix(), sent.ID, m.SuppressModMessage, m.Content)
@@ -127,6 +252,25 @@ func (s *Service) CheckAndSendDue(session *discordgo.Session) error {
 	return nil
 }
 
+// sendScheduled posts m and returns its first message.
+func sendScheduled(session *discordgo.Session, m ScheduledMessage) (*discordgo.Message, error) {
+	chunks, err := sayChunks(withModFooter(m.Content, m.SuppressModMessage), m.Split)
+	if err != nil {
+		return nil, fmt.Errorf("scheduled message %d for channel %s: %w", m.ID, m.ChannelID, err)
+	}
+	var sent *discordgo.Message
+	for _, chunk := range chunks {
+		msg, err := session.ChannelMessageSendComplex(m.ChannelID, sayMessage(chunk, m.AllowPings))
+		if err != nil {
+			return nil, fmt.Errorf("failed sending scheduled message to channel %s: %w", m.ChannelID, err)
+		}
+		if sent == nil {
+			sent = msg
+		}
+	}
+	return sent, nil
+}
+
 // CheckDue checks and sends due scheduled messages using the stored session
 func (s *Service) CheckDue() error {
 	if s.Session == nil {
@@ -0,0 +1,117 @@
+package say
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gamerpal/internal/config"
+	"gamerpal/internal/database"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceListPages(t *testing.T) {
+	svc := NewService(config.NewMockConfig(map[string]any{"bot_token": "x"}), nil)
+	base := time.Now().Add(time.Hour)
+	for n := range 5 {
+		// Added out of order; the queue is kept soonest first.
+		_, err := svc.Add(ScheduledMessage{ChannelID: "c", Content: "m", FireAt: base.Add(time.Duration(5-n) * time.Minute)})
+		require.NoError(t, err)
+	}
+
+	page, current, pages, total := svc.Page(1, 2)
+	require.Equal(t, 5, total)
+	require.Equal(t, 3, pages)
+	require.Equal(t, 1, current)
+	require.Len(t, page, 2)
+	require.True(t, page[0].FireAt.Before(page[1].FireAt))
+
+	page, current, _, _ = svc.Page(3, 2)
+	require.Equal(t, 3, current)
+	require.Len(t, page, 1)
+
+	// Out-of-range pages are clamped.
+	page, current, _, _ = svc.Page(9, 2)
+	require.Equal(t, 3, current)
+	require.Len(t, page, 1)
+	_, current, _, _ = svc.Page(0, 2)
+	require.Equal(t, 1, current)
+
+	// Cancelled messages drop out of the listing.
+	first, _, _, _ := svc.Page(1, 1)
+	require.True(t, svc.Cancel(first[0].ID))
+	page, _, pages, total = svc.Page(1, 10)
+	require.Equal(t, 4, total)
+	require.Equal(t, 1, pages)
+	for _, msg := range page {
+		require.NotEqual(t, first[0].ID, msg.ID)
+	}
+
+	// An empty queue has no pages.
+	for _, msg := range page {
+		require.True(t, svc.Cancel(msg.ID))
+	}
+	page, current, pages, total = svc.Page(1, 10)
+	require.Empty(t, page)
+	require.Zero(t, current+pages+total)
+}
+
+func TestServiceEdit(t *testing.T) {
+	svc := NewService(config.NewMockConfig(map[string]any{"bot_token": "x"}), nil)
+	base := time.Now().Add(time.Hour)
+	first, err := svc.Add(ScheduledMessage{ChannelID: "c1", Content: "first", FireAt: base, ScheduledBy: "mod", Split: true})
+	require.NoError(t, err)
+	second, err := svc.Add(ScheduledMessage{ChannelID: "c1", Content: "second", FireAt: base.Add(time.Minute)})
+	require.NoError(t, err)
+
+	updated, ok, err := svc.Edit(first, "c2", "edited", base.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, first, updated.ID)
+	require.Equal(t, "c2", updated.ChannelID)
+	require.Equal(t, "edited", updated.Content)
+	require.Equal(t, "mod", updated.ScheduledBy, "unedited settings are kept")
+	require.True(t, updated.Split)
+
+	// The queue stays ordered by fire time after an edit.
+	page, _, _, _ := svc.Page(1, 10)
+	require.Equal(t, []int64{second, first}, []int64{page[0].ID, page[1].ID})
+
+	require.True(t, svc.Cancel(second))
+	_, ok, err = svc.Edit(second, "c1", "too late", base)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok = svc.Get(second)
+	require.False(t, ok)
+}
+
+func TestServicePersistsQueue(t *testing.T) {
+	db, err := database.NewDB(filepath.Join(t.TempDir(), "say.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	cfg := config.NewMockConfig(map[string]any{"bot_token": "x"})
+	base := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	svc := NewService(cfg, db)
+	kept, err := svc.Add(ScheduledMessage{ChannelID: "c1", Content: "kept", FireAt: base, ScheduledBy: "mod", AllowPings: true})
+	require.NoError(t, err)
+	cancelled, err := svc.Add(ScheduledMessage{ChannelID: "c1", Content: "cancelled", FireAt: base})
+	require.NoError(t, err)
+	_, _, err = svc.Edit(kept, "c2", "edited", base.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, svc.Cancel(cancelled))
+
+	// A new service over the same database, as after a crash with no clean
+	// shutdown, sees the queue with the same IDs.
+	restarted := NewService(cfg, db)
+	require.NoError(t, restarted.HydrateServiceDiscordSession(nil))
+	msg, ok := restarted.Get(kept)
+	require.True(t, ok)
+	require.Equal(t, "c2", msg.ChannelID)
+	require.Equal(t, "edited", msg.Content)
+	require.True(t, msg.AllowPings)
+	_, ok = restarted.Get(cancelled)
+	require.False(t, ok)
+	require.Equal(t, 1, restarted.Pending())
+}
@@ -0,0 +1,165 @@
+package timezone
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/database"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Module implements the CommandModule interface for /timezone.
+type Module struct {
+	config *config.Config
+	db     *database.DB
+}
+
+// New creates a new timezone module
+func New(deps *types.Dependencies) *Module {
+	return &Module{
+		config: deps.Config,
+		db:     deps.DB,
+	}
+}
+
+// Register adds the /timezone command
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	cmds["timezone"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:        "timezone",
+			Description: "Set the timezone used when you type times like 3pm",
+			Contexts:    &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Set your timezone",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "zone",
+							Description: "IANA timezone, e.g. Europe/Berlin or America/New_York",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show your timezone",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "clear",
+					Description: "Forget your timezone and use UTC",
+				},
+			},
+		},
+		HandlerFunc: m.handleTimezone,
+	}
+}
+
+// Service returns nil; this module has no recurring service.
+func (m *Module) Service() types.ModuleService { return nil }
+
+// normalizeZone fixes the casing of common inputs ("utc", "europe/berlin")
+// and reports whether the result is a loadable IANA zone.
+func normalizeZone(input string) (string, bool) {
+	zone := strings.TrimSpace(input)
+	switch {
+	case zone == "", strings.EqualFold(zone, "local"): // "Local" is the server's zone
+		return "", false
+	case strings.EqualFold(zone, "utc"):
+		return "UTC", true
+	}
+	if _, err := time.LoadLocation(zone); err == nil {
+		return zone, true
+	}
+	parts := strings.Split(strings.ReplaceAll(zone, " ", "_"), "/")
+	for idx, part := range parts {
+		words := strings.Split(strings.ToLower(part), "_")
+		for w, word := range words {
+			if word != "" {
+				words[w] = strings.ToUpper(word[:1]) + word[1:]
+			}
+		}
+		parts[idx] = strings.Join(words, "_")
+	}
+	zone = strings.Join(parts, "/")
+	if _, err := time.LoadLocation(zone); err != nil {
+		return "", false
+	}
+	return zone, true
+}
+
+func (m *Module) handleTimezone(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	respond := func(content string) {
+		_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+
+	if m.db == nil {
+		respond("❌ Database is unavailable.")
+		return
+	}
+	if i.Member == nil || i.Member.User == nil {
+		return
+	}
+	userID := i.Member.User.ID
+	data := i.ApplicationCommandData()
+	if len(data.Options) == 0 {
+		respond("❌ Missing subcommand")
+		return
+	}
+	sub := data.Options[0]
+
+	switch sub.Name {
+	case "set":
+		var input string
+		for _, opt := range sub.Options {
+			if opt.Name == "zone" {
+				input = opt.StringValue()
+			}
+		}
+		zone, ok := normalizeZone(input)
+		if !ok {
+			respond(fmt.Sprintf("❌ `%s` isn't a timezone I know. Use an IANA name like `Europe/Berlin` or `America/New_York`.", input))
+			return
+		}
+		if err := m.db.SetUserTimezone(userID, zone); err != nil {
+			m.config.Logger.Errorf("Failed to set timezone: %v", err)
+			respond("❌ Failed to save your timezone.")
+			return
+		}
+		loc, _ := time.LoadLocation(zone)
+		respond(fmt.Sprintf("✅ Timezone set to **%s** (it's %s there now).", zone, time.Now().In(loc).Format("Mon 15:04")))
+	case "show":
+		loc, err := m.db.GetUserTimezone(userID)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to get timezone: %v", err)
+			respond("❌ Failed to load your timezone.")
+			return
+		}
+		respond(fmt.Sprintf("Your timezone is **%s** (it's %s there now). Times you type without a zone use it.", loc, time.Now().In(loc).Format("Mon 15:04")))
+	case "clear":
+		cleared, err := m.db.ClearUserTimezone(userID)
+		if err != nil {
+			m.config.Logger.Errorf("Failed to clear timezone: %v", err)
+			respond("❌ Failed to clear your timezone.")
+			return
+		}
+		if !cleared {
+			respond("You have no timezone set; UTC is used.")
+			return
+		}
+		respond("✅ Timezone cleared; UTC will be used.")
+	default:
+		respond("❌ Unknown subcommand")
+	}
+}
@@ -0,0 +1,27 @@
+package timezone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeZone(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"Europe/Berlin", "Europe/Berlin", true},
+		{" europe/berlin ", "Europe/Berlin", true},
+		{"america/new york", "America/New_York", true},
+		{"utc", "UTC", true},
+		{"Local", "", false},
+		{"", "", false},
+		{"Mars/Olympus", "", false},
+	} {
+		got, ok := normalizeZone(tc.in)
+		require.Equal(t, tc.ok, ok, tc.in)
+		require.Equal(t, tc.want, got, tc.in)
+	}
+}
@@ -0,0 +1,18 @@
+package userstats
+
+import "gamerpal/internal/config"
+
+// ConfigSettings declares the per-guild settings owned by the userstats
+// module, auto-collected into the config panel registry.
+func (m *Module) ConfigSettings() []config.Setting {
+	return []config.Setting{
+		{
+			Key:         config.KeyUserStatsCooldown,
+			Category:    config.CategoryMisc,
+			Label:       "/userstats cooldown",
+			Description: "How long before a moderator can run /userstats again (e.g. 30s). 0s disables.",
+			Kind:        config.KindDuration,
+			Default:     "30s",
+		},
+	}
+}
@@ -3,6 +3,7 @@ package userstats
 import (
 	"fmt"
 	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
 	"gamerpal/internal/utils"
 	"slices"
 	"strings"
@@ -12,11 +13,13 @@ import (
 )
 
 // Module implements the CommandModule interface for the userstats command
-type Module struct{}
+type Module struct {
+	config *config.Config
+}
 
 // New creates a new userstats module
 func New(deps *types.Dependencies) *Module {
-	return &Module{}
+	return &Module{config: deps.Config}
 }
 
 // Register adds the userstats command to the command map
@@ -49,6 +52,7 @@ func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependenci
 			},
 		},
 		HandlerFunc: m.handleUserStats,
+		Cooldown:    &types.Cooldown{Window: (*config.GuildConfig).GetUserStatsCooldown, Scope: types.CooldownPerUser},
 	}
 }
 
@@ -68,9 +72,9 @@ func (m *Module) handleUserStats(s *discordgo.Session, i *discordgo.InteractionC
 	// Get guild members
 	members, err := utils.GetAllGuildMembers(s, i.GuildID)
 	if err != nil {
-		_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{
 			Content: new("❌ Error fetching server members: " + err.Error()),
-		})
+		}, false)
 		return
 	}
 
@@ -230,9 +234,9 @@ func (m *Module) handleOverviewStats(s *discordgo.Session, i *discordgo.Interact
 	}
 
 	// Send the response
-	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+	_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{
 		Embeds: &[]*discordgo.MessageEmbed{embed},
-	})
+	}, false)
 }
 
 // handleDailyStats handles the daily statistics display for the last 7 days
@@ -312,9 +316,9 @@ func (m *Module) handleDailyStats(s *discordgo.Session, i *discordgo.Interaction
 	}
 
 	// Send the response
-	_, _ = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+	_ = utils.EditDeferredResponse(m.config, s, i.Interaction, &discordgo.WebhookEdit{
 		Embeds: &[]*discordgo.MessageEmbed{embed},
-	})
+	}, false)
 }
 
 func formatRegionCounts(regionCounts map[string]int) string {
@@ -0,0 +1,232 @@
+package whois
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gamerpal/internal/commands/types"
+	"gamerpal/internal/config"
+	"gamerpal/internal/database"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxRolesShown caps the role mentions listed so the field stays readable.
+const maxRolesShown = 20
+
+// Module implements the CommandModule interface for /whois.
+type Module struct {
+	config     *config.Config
+	db         *database.DB
+	forumCache *forumcache.Service
+}
+
+// New creates a new whois module
+func New(deps *types.Dependencies) *Module {
+	return &Module{
+		config:     deps.Config,
+		db:         deps.DB,
+		forumCache: deps.ForumCache,
+	}
+}
+
+// Register adds the /whois command
+func (m *Module) Register(cmds map[string]*types.Command, deps *types.Dependencies) {
+	var modPerms int64 = discordgo.PermissionBanMembers
+	cmds["whois"] = &types.Command{
+		ApplicationCommand: &discordgo.ApplicationCommand{
+			Name:                     "whois",
+			Description:              "Summarize a member's join date, roles, introduction and LFG activity",
+			DefaultMemberPermissions: &modPerms,
+			Contexts:                 &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild},
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The member to look up (defaults to you)",
+					Required:    false,
+				},
+			},
+		},
+		HandlerFunc: m.handleWhois,
+	}
+}
+
+// Service returns nil; this module has no recurring service.
+func (m *Module) Service() types.ModuleService { return nil }
+
+// profile is everything /whois knows about a user. Each section is optional;
+// the embed says so when a subsystem has nothing.
+type profile struct {
+	User      *discordgo.User
+	CreatedAt time.Time
+	Member    *discordgo.Member // nil when the user isn't in the guild
+
+	IntroForumSet bool
+	Intro         *forumcache.ThreadMeta
+	FeedKnown     bool // intro feed history was readable
+	FeedPosts     int
+	LastFeedPost  time.Time
+
+	LFGForumSet bool
+	LFG         *forumcache.ThreadMeta
+}
+
+func (m *Module) handleWhois(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var user *discordgo.User
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "user" {
+			user = opt.UserValue(s)
+		}
+	}
+	if user == nil && i.Member != nil {
+		user = i.Member.User
+	}
+	if user == nil {
+		respondEphemeral(s, i, "❌ Couldn't determine which user to look up.")
+		return
+	}
+
+	p := m.gather(s, i.GuildID, user)
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{whoisEmbed(i.GuildID, p)},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// gather reads the profile from state, the forum cache and the database.
+// Failures only blank out the affected section.
+func (m *Module) gather(s *discordgo.Session, guildID string, user *discordgo.User) profile {
+	p := profile{User: user}
+	p.CreatedAt, _ = discordgo.SnowflakeTimestamp(user.ID)
+
+	if s.State != nil {
+		p.Member, _ = s.State.Member(guildID, user.ID)
+	}
+	if p.Member == nil {
+		p.Member, _ = s.GuildMember(guildID, user.ID)
+	}
+
+	gc := m.config.ForGuild(guildID)
+	if forumID := gc.GetGamerPalsIntroductionsForumChannelID(); forumID != "" {
+		p.IntroForumSet = true
+		if m.forumCache != nil {
+			p.Intro, _ = m.forumCache.GetLatestUserThread(forumID, user.ID)
+		}
+	}
+	if forumID := gc.GetGamerPalsLFGForumChannelID(); forumID != "" {
+		p.LFGForumSet = true
+		if m.forumCache != nil {
+			p.LFG, _ = m.forumCache.GetLatestUserThread(forumID, user.ID)
+		}
+	}
+
+	if m.db != nil {
+		count, countErr := m.db.GetUserIntroPostCount(user.ID)
+		last, lastErr := m.db.GetLastIntroFeedPostTime(user.ID)
+		if countErr != nil || lastErr != nil {
+			m.config.Logger.Warnf("whois: failed to read intro feed history for %s: %v", user.ID, errors.Join(countErr, lastErr))
+		} else {
+			p.FeedKnown, p.FeedPosts, p.LastFeedPost = true, count, last
+		}
+	}
+	return p
+}
+
+// whoisEmbed renders a profile.
+func whoisEmbed(guildID string, p profile) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:     fmt.Sprintf("👤 %s", p.User.Username),
+		Color:     utils.Colors.Info(),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: p.User.AvatarURL("128")},
+		Footer:    &discordgo.MessageEmbedFooter{Text: "User ID: " + p.User.ID},
+	}
+
+	account := "Unknown"
+	if !p.CreatedAt.IsZero() {
+		account = fmt.Sprintf("<t:%d:D> (<t:%d:R>)", p.CreatedAt.Unix(), p.CreatedAt.Unix())
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Account Created", Value: account, Inline: true})
+
+	if p.Member == nil {
+		embed.Description = p.User.Mention() + " is not a member of this server."
+		embed.Color = utils.Colors.Warning()
+	} else {
+		embed.Description = p.User.Mention()
+		joined := "Unknown"
+		if !p.Member.JoinedAt.IsZero() {
+			joined = fmt.Sprintf("<t:%d:D> (<t:%d:R>)", p.Member.JoinedAt.Unix(), p.Member.JoinedAt.Unix())
+		}
+		embed.Fields = append(embed.Fields,
+			&discordgo.MessageEmbedField{Name: "Joined Server", Value: joined, Inline: true},
+			&discordgo.MessageEmbedField{Name: fmt.Sprintf("Roles (%d)", len(p.Member.Roles)), Value: formatRoles(p.Member.Roles)},
+		)
+	}
+
+	intro := "Introductions forum not configured"
+	if p.IntroForumSet {
+		intro = formatThread(guildID, p.Intro, "No introduction found")
+	}
+	if p.FeedKnown {
+		feed := fmt.Sprintf("Intro feed posts: %d", p.FeedPosts)
+		if !p.LastFeedPost.IsZero() {
+			feed += fmt.Sprintf(" (last <t:%d:R>)", p.LastFeedPost.Unix())
+		}
+		intro += "\n" + feed
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Latest Introduction", Value: intro})
+
+	lfg := "LFG forum not configured"
+	if p.LFGForumSet {
+		lfg = formatThread(guildID, p.LFG, "No LFG thread found")
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Latest LFG Thread", Value: lfg})
+
+	return embed
+}
+
+// formatThread links a cached thread with its creation time, or returns none.
+func formatThread(guildID string, t *forumcache.ThreadMeta, none string) string {
+	if t == nil {
+		return none
+	}
+	line := fmt.Sprintf("[%s](https://discord.com/channels/%s/%s)", t.Name, guildID, t.ID)
+	if !t.CreatedAt.IsZero() {
+		line += fmt.Sprintf(" • <t:%d:R>", t.CreatedAt.Unix())
+	}
+	if t.Archived {
+		line += " • archived"
+	}
+	return line
+}
+
+// formatRoles lists role mentions, capped at maxRolesShown.
+func formatRoles(roleIDs []string) string {
+	if len(roleIDs) == 0 {
+		return "None"
+	}
+	shown := roleIDs[:min(len(roleIDs), maxRolesShown)]
+	mentions := make([]string, 0, len(shown))
+	for _, id := range shown {
+		mentions = append(mentions, "<@&"+id+">")
+	}
+	out := strings.Join(mentions, " ")
+	if extra := len(roleIDs) - len(shown); extra > 0 {
+		out += fmt.Sprintf(" …and %d more", extra)
+	}
+	return out
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	})
+}
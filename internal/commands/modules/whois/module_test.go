@@ -0,0 +1,70 @@
+package whois
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gamerpal/internal/forumcache"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func fieldValues(e *discordgo.MessageEmbed) map[string]string {
+	out := make(map[string]string, len(e.Fields))
+	for _, f := range e.Fields {
+		out[f.Name] = f.Value
+	}
+	return out
+}
+
+func TestWhoisEmbed(t *testing.T) {
+	user := &discordgo.User{ID: "42", Username: "pal"}
+	created := time.Unix(1_600_000_000, 0)
+	joined := time.Unix(1_700_000_000, 0)
+
+	t.Run("full profile", func(t *testing.T) {
+		e := whoisEmbed("G", profile{
+			User:          user,
+			CreatedAt:     created,
+			Member:        &discordgo.Member{JoinedAt: joined, Roles: []string{"r1", "r2"}},
+			IntroForumSet: true,
+			Intro:         &forumcache.ThreadMeta{ID: "T1", Name: "Hi I'm pal", CreatedAt: joined},
+			FeedKnown:     true,
+			FeedPosts:     2,
+			LFGForumSet:   true,
+			LFG:           &forumcache.ThreadMeta{ID: "T2", Name: "Halo", Archived: true},
+		})
+		f := fieldValues(e)
+		require.Equal(t, "<@42>", e.Description)
+		require.Equal(t, fmt.Sprintf("<t:%d:D> (<t:%d:R>)", created.Unix(), created.Unix()), f["Account Created"])
+		require.Equal(t, fmt.Sprintf("<t:%d:D> (<t:%d:R>)", joined.Unix(), joined.Unix()), f["Joined Server"])
+		require.Equal(t, "<@&r1> <@&r2>", f["Roles (2)"])
+		require.Equal(t, fmt.Sprintf("[Hi I'm pal](https://discord.com/channels/G/T1) • <t:%d:R>\nIntro feed posts: 2", joined.Unix()), f["Latest Introduction"])
+		require.Equal(t, "[Halo](https://discord.com/channels/G/T2) • archived", f["Latest LFG Thread"])
+	})
+
+	t.Run("sections degrade when data is missing", func(t *testing.T) {
+		e := whoisEmbed("G", profile{User: user, LFGForumSet: true})
+		f := fieldValues(e)
+		require.Contains(t, e.Description, "not a member")
+		require.Equal(t, "Unknown", f["Account Created"])
+		require.NotContains(t, f, "Joined Server")
+		require.Equal(t, "Introductions forum not configured", f["Latest Introduction"])
+		require.Equal(t, "No LFG thread found", f["Latest LFG Thread"])
+	})
+}
+
+func TestFormatRoles(t *testing.T) {
+	require.Equal(t, "None", formatRoles(nil))
+
+	roles := make([]string, maxRolesShown+3)
+	for i := range roles {
+		roles[i] = fmt.Sprint(i)
+	}
+	out := formatRoles(roles)
+	require.Contains(t, out, "<@&0>")
+	require.NotContains(t, out, fmt.Sprintf("<@&%d>", maxRolesShown))
+	require.Contains(t, out, "…and 3 more")
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"gamerpal/internal/commands/modules/say"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/telemetry"
+)
+
+// EnableTelemetry registers command, forum cache and scheduled-say metrics
+// with reg and starts counting command invocations. Call before the session
+// opens; without it the counters stay nil and cost nothing.
+func (h *ModuleHandler) EnableTelemetry(reg *telemetry.Registry) {
+	h.commandsTotal = reg.NewCounterVec("gamerpal_commands_total", "Slash commands handled, by command.", "command")
+	h.commandPanics = reg.NewCounterVec("gamerpal_command_panics_total", "Slash commands whose handler panicked, by command.", "command")
+
+	fc := h.deps.ForumCache
+	perForum := func(value func(forumcache.ForumStats) float64) func() []telemetry.Sample {
+		return func() []telemetry.Sample {
+			var out []telemetry.Sample
+			for _, id := range fc.RegisteredForums() {
+				if st, ok := fc.Stats(id); ok {
+					out = append(out, telemetry.Sample{LabelValues: []string{id}, Value: value(st)})
+				}
+			}
+			return out
+		}
+	}
+	forum := []string{"forum"}
+	reg.GaugeFunc("gamerpal_forum_cache_threads", "Threads held in the forum cache, by forum.", forum,
+		perForum(func(st forumcache.ForumStats) float64 { return float64(st.Threads) }))
+	reg.GaugeFunc("gamerpal_forum_cache_owners", "Thread owners tracked in the forum cache, by forum.", forum,
+		perForum(func(st forumcache.ForumStats) float64 { return float64(st.OwnersTracked) }))
+	reg.CounterFunc("gamerpal_forum_cache_anomalies_total", "Forum cache anomalies (unknown-thread events and reconcile corrections), by forum.", forum,
+		perForum(func(st forumcache.ForumStats) float64 { return float64(st.Anomalies) }))
+	reg.CounterFunc("gamerpal_forum_cache_events_total", "Forum cache thread events applied, by forum and event.", []string{"forum", "event"}, func() []telemetry.Sample {
+		var out []telemetry.Sample
+		for _, id := range fc.RegisteredForums() {
+			st, ok := fc.Stats(id)
+			if !ok {
+				continue
+			}
+			out = append(out,
+				telemetry.Sample{LabelValues: []string{id, "add"}, Value: float64(st.EventAdds)},
+				telemetry.Sample{LabelValues: []string{id, "update"}, Value: float64(st.EventUpdates)},
+				telemetry.Sample{LabelValues: []string{id, "delete"}, Value: float64(st.EventDeletes)},
+				telemetry.Sample{LabelValues: []string{id, "message"}, Value: float64(st.EventMessages)},
+			)
+		}
+		return out
+	})
+
+	if sayMod, ok := h.GetModule("say").(*say.Module); ok {
+		if svc, ok := sayMod.Service().(*say.Service); ok {
+			reg.GaugeFunc("gamerpal_scheduled_says_pending", "Scheduled /say messages waiting to be sent.", nil, func() []telemetry.Sample {
+				return []telemetry.Sample{{Value: float64(svc.Pending())}}
+			})
+		}
+	}
+}
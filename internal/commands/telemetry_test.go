@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"gamerpal/internal/commands/types"
+	internalConfig "gamerpal/internal/config"
+	"gamerpal/internal/forumcache"
+	"gamerpal/internal/telemetry"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableTelemetryCountsCommands(t *testing.T) {
+	_, fc := forumcache.NewTestForumCache(nil)
+	fc.RegisterForum("f1")
+	fc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: &discordgo.Channel{ID: "10", ParentID: "f1", OwnerID: "u1", Name: "Elden Ring"}})
+
+	h := &ModuleHandler{
+		commands: map[string]*types.Command{
+			"ping": {ApplicationCommand: &discordgo.ApplicationCommand{Name: "ping"}, HandlerFunc: func(*discordgo.Session, *discordgo.InteractionCreate) {}},
+		},
+		modules: map[string]types.CommandModule{},
+		config:  internalConfig.NewMockConfig(map[string]any{}),
+		deps:    &types.Dependencies{ForumCache: fc},
+	}
+	reg := telemetry.NewRegistry()
+	h.EnableTelemetry(reg)
+
+	inter := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type: discordgo.InteractionApplicationCommand,
+		Data: discordgo.ApplicationCommandInteractionData{Name: "ping"},
+	}}
+	h.HandleInteraction(&discordgo.Session{}, inter)
+	h.HandleInteraction(&discordgo.Session{}, inter)
+	h.recordCommandUsage("ping", inter, false)
+
+	var b strings.Builder
+	require.NoError(t, reg.WriteText(&b))
+	out := b.String()
+	assert.Contains(t, out, `gamerpal_commands_total{command="ping"} 3`)
+	assert.Contains(t, out, `gamerpal_command_panics_total{command="ping"} 1`)
+	assert.Contains(t, out, `gamerpal_forum_cache_threads{forum="f1"} 1`)
+	assert.Contains(t, out, `gamerpal_forum_cache_events_total{forum="f1",event="add"} 1`)
+}
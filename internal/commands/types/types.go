@@ -1,9 +1,13 @@
 package types
 
 import (
+	"context"
+	"time"
+
 	"gamerpal/internal/config"
 	"gamerpal/internal/database"
 	"gamerpal/internal/forumcache"
+	"gamerpal/internal/games"
 
 	"github.com/Henry-Sarabia/igdb/v2"
 	"github.com/bwmarrin/discordgo"
@@ -14,6 +18,32 @@ type Command struct {
 	ApplicationCommand *discordgo.ApplicationCommand
 	HandlerFunc        func(s *discordgo.Session, i *discordgo.InteractionCreate)
 	Development        bool
+	// Cooldown optionally throttles how often the command can run. Nil means
+	// no cooldown.
+	Cooldown *Cooldown
+	// SubcommandCooldowns throttles individual subcommands, keyed by
+	// subcommand name. A subcommand listed here uses its own cooldown instead
+	// of Cooldown.
+	SubcommandCooldowns map[string]*Cooldown
+}
+
+// CooldownScope selects who shares a command cooldown.
+type CooldownScope int
+
+const (
+	// CooldownPerUser throttles each user independently.
+	CooldownPerUser CooldownScope = iota
+	// CooldownPerGuild throttles the command for everyone in a guild.
+	CooldownPerGuild
+)
+
+// Cooldown is the minimum time between runs of a command within its scope.
+// Administrators bypass cooldowns.
+type Cooldown struct {
+	// Window returns the guild's cooldown window, so it can be configured per
+	// guild. A window <= 0 disables the cooldown.
+	Window func(gc *config.GuildConfig) time.Duration
+	Scope  CooldownScope
 }
 
 // BaseService provides common session hydration functionality for all services
@@ -41,6 +71,13 @@ type ModuleService interface {
 	ScheduledFuncs() map[string]func() error
 }
 
+// ShutdownService is optionally implemented by a ModuleService that holds
+// transient state which must be flushed or cleaned up before the bot exits.
+// Implementations should return promptly once ctx is done.
+type ShutdownService interface {
+	Shutdown(ctx context.Context) error
+}
+
 // CommandModule represents a module that can register commands
 // Each module should contain:
 // - Command definition(s)
@@ -60,6 +97,7 @@ type Dependencies struct {
 	Config     *config.Config
 	DB         *database.DB
 	IGDBClient *igdb.Client
+	IGDBTokens *games.TokenManager
 	Session    *discordgo.Session
 	ForumCache *forumcache.Service
 }
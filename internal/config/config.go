@@ -32,6 +32,10 @@ type Config struct {
 	// entry means "not loaded yet".
 	overrideCacheMu sync.RWMutex
 	overrideCache   map[string]map[string]string
+
+	// logTally counts warn/error log lines for the hourly digest. It is one
+	// of the logger's output writers, so it must be re-attached on rotation.
+	logTally *LogTally
 }
 
 // SetGuildStore wires the per-guild override store. Called once at startup
@@ -86,8 +90,10 @@ func NewConfig() (*Config, error) {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
-	// Always log to stderr; optionally also tee to a rotating log file.
-	writers := []io.Writer{os.Stderr}
+	// Always log to stderr and the digest tally; optionally also tee to a
+	// rotating log file.
+	tally := NewLogTally()
+	writers := []io.Writer{os.Stderr, tally}
 	if !v.GetBool("disable_file_logging") {
 		newLogFile, err := newLogFile(v.GetString("log_dir"))
 		if err != nil {
@@ -111,6 +117,7 @@ func NewConfig() (*Config, error) {
 			ReportTimestamp: true,
 			TimeFormat:      time.Kitchen,
 		}),
+		logTally: tally,
 	}
 
 	// Validate required fields
@@ -161,8 +168,11 @@ func (c *Config) RotateAndPruneLogs() error {
 		return fmt.Errorf("failed to rotate and create new log file: %w", err)
 	}
 
-	w := io.MultiWriter(os.Stderr, newLogFile)
-	c.Logger.SetOutput(w)
+	writers := []io.Writer{os.Stderr, newLogFile}
+	if c.logTally != nil {
+		writers = append(writers, c.logTally)
+	}
+	c.Logger.SetOutput(io.MultiWriter(writers...))
 
 	// After rotating, we can prune old log files
 	err = pruneOldLogFiles(c.v.GetString("log_dir"))
@@ -219,9 +229,32 @@ func setDefaults(v *viper.Viper) {
 	// Add any default values here if needed in the future
 	v.SetDefault("log_dir", "./logs")
 	v.SetDefault("database_path", "./gamerpal.db")
+	v.SetDefault("database_busy_timeout", "5s")
+	v.SetDefault("database_wal", false)
+	v.SetDefault("forum_cache_max_archived_pages", 0)
+	v.SetDefault("forum_cache_preserve_digit_separators", false)
+	v.SetDefault("forum_cache_keep_symbols", "")
+	v.SetDefault("health_port", 0)
+	v.SetDefault("metrics_enabled", false)
 	v.SetDefault("translate_language", "random")
 	v.SetDefault("disable_file_logging", false)
 
+	// account age gate defaults (off unless enabled)
+	v.SetDefault("account_age_gate_enabled", false)
+	v.SetDefault("account_age_gate_min_account_age", "168h")
+	v.SetDefault("account_age_gate_min_member_age", "0s")
+	v.SetDefault("account_age_gate_exempt_commands", "help")
+
+	// scheduled prune report defaults (off unless enabled)
+	v.SetDefault("prune_report_enabled", false)
+	v.SetDefault("prune_report_interval", "168h")
+
+	// command cooldown defaults ("0s" disables a cooldown)
+	v.SetDefault("prune_cooldown", "2m")
+	v.SetDefault("userstats_cooldown", "30s")
+	v.SetDefault("lfg_game_thread_cooldown", "15s")
+	v.SetDefault("lfg_refresh_cache_cooldown", "5m")
+
 	// scamguard (anti-scam image detection) defaults
 	v.SetDefault("scamguard_enabled", false)
 	v.SetDefault("scamguard_hash_threshold", 8)
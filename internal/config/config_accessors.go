@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"slices"
 	"strings"
 	"time"
 )
@@ -43,6 +44,13 @@ func (c *Config) GetGamerPalsServerID() string {
 	return c.v.GetString("gamerpals_server_id")
 }
 
+// GetDevGuildID returns the guild slash commands are registered to during
+// development. Guild-scoped commands propagate instantly; when empty, commands
+// are registered globally. Bootstrap/infra setting: env-only, never per-guild.
+func (c *Config) GetDevGuildID() string {
+	return c.v.GetString("dev_guild_id")
+}
+
 // Per-guild settings (delegators)
 // -----
 //
@@ -149,6 +157,10 @@ func (c *Config) GetGamerPalsLFGForumChannelID() string {
 	return c.PrimaryGuild().GetGamerPalsLFGForumChannelID()
 }
 
+func (c *Config) GetLFGForumChannelIDs() []string {
+	return c.PrimaryGuild().GetLFGForumChannelIDs()
+}
+
 func (c *Config) GetGamerPalsVoiceSyncCategoryID() string {
 	return c.PrimaryGuild().GetGamerPalsVoiceSyncCategoryID()
 }
@@ -222,11 +234,78 @@ func (c *Config) GetSuperAdmins() []string {
 	return out
 }
 
+// IsBootstrapSuperAdmin reports whether userID is in the env/file
+// super_admins list. These can't be removed at runtime, so an operator can't
+// lock everyone out from the config panel.
+func (c *Config) IsBootstrapSuperAdmin(userID string) bool {
+	return userID != "" && slices.Contains(c.GetSuperAdmins(), userID)
+}
+
+// IsSuperAdmin reports whether userID is a bootstrap super admin or was added
+// through the primary guild's extra_super_admin_ids setting.
+func (c *Config) IsSuperAdmin(userID string) bool {
+	if c.IsBootstrapSuperAdmin(userID) {
+		return true
+	}
+	return userID != "" && slices.Contains(c.PrimaryGuild().GetExtraSuperAdminIDs(), userID)
+}
+
 func (c *Config) GetDatabasePath() string {
 	dbPath := c.v.GetString("database_path")
 	return dbPath
 }
 
+// GetDatabaseBusyTimeout returns how long SQLite waits on a locked database
+// before failing. Unset or invalid values fall back to 5s. Bootstrap/infra
+// setting: env-only, never per-guild.
+func (c *Config) GetDatabaseBusyTimeout() time.Duration {
+	if d := c.v.GetDuration("database_busy_timeout"); d > 0 {
+		return d
+	}
+	return 5 * time.Second
+}
+
+// GetDatabaseWAL reports whether SQLite should use write-ahead logging. Only
+// safe on local disks. Bootstrap/infra setting: env-only, never per-guild.
+func (c *Config) GetDatabaseWAL() bool {
+	return c.v.GetBool("database_wal")
+}
+
+// GetForumCacheMaxArchivedPages caps how many archived-thread pages a forum
+// cache rebuild fetches per forum. 0 (the default) means no cap. Bootstrap/
+// infra setting: env-only, never per-guild.
+func (c *Config) GetForumCacheMaxArchivedPages() int {
+	return max(c.v.GetInt("forum_cache_max_archived_pages"), 0)
+}
+
+// GetForumCachePreserveDigitSeparators reports whether forum cache name
+// matching keeps separators between digits ("1-2-Switch"). Bootstrap/infra
+// setting: env-only, never per-guild.
+func (c *Config) GetForumCachePreserveDigitSeparators() bool {
+	return c.v.GetBool("forum_cache_preserve_digit_separators")
+}
+
+// GetForumCacheKeepSymbols returns extra symbols forum cache name matching
+// keeps instead of dropping (e.g. "+#"). Bootstrap/infra setting: env-only,
+// never per-guild.
+func (c *Config) GetForumCacheKeepSymbols() string {
+	return c.v.GetString("forum_cache_keep_symbols")
+}
+
+// GetHealthPort returns the port for the /healthz and /readyz HTTP server.
+// 0 (the default) disables the server. Bootstrap/infra setting: env-only,
+// never per-guild.
+func (c *Config) GetHealthPort() int {
+	return c.v.GetInt("health_port")
+}
+
+// GetMetricsEnabled reports whether Prometheus metrics are collected and
+// served at /metrics on the health server (requires health_port).
+// Bootstrap/infra setting: env-only, never per-guild.
+func (c *Config) GetMetricsEnabled() bool {
+	return c.v.GetBool("metrics_enabled")
+}
+
 func (c *Config) GetLogDir() string {
 	return c.v.GetString("log_dir")
 }
@@ -117,6 +117,28 @@ func TestGetSuperAdmins(t *testing.T) {
 	})
 }
 
+func TestIsSuperAdmin(t *testing.T) {
+	t.Run("empty lists grant nobody", func(t *testing.T) {
+		cfg := NewMockConfig(map[string]any{"super_admins": []string{}})
+		require.False(t, cfg.IsSuperAdmin("anyone"))
+		require.False(t, cfg.IsSuperAdmin(""))
+		require.False(t, cfg.IsBootstrapSuperAdmin("anyone"))
+	})
+
+	t.Run("bootstrap and extra admins", func(t *testing.T) {
+		cfg := NewMockConfig(map[string]any{
+			"super_admins":          []string{"owner"},
+			"extra_super_admin_ids": "helper1, helper2",
+		})
+		require.True(t, cfg.IsSuperAdmin("owner"))
+		require.True(t, cfg.IsBootstrapSuperAdmin("owner"))
+		require.True(t, cfg.IsSuperAdmin("helper2"))
+		require.False(t, cfg.IsBootstrapSuperAdmin("helper2"), "extra admins can't manage the list")
+		require.False(t, cfg.IsSuperAdmin("stranger"))
+		require.False(t, cfg.IsSuperAdmin(""))
+	})
+}
+
 func TestScamGuardDefaults(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Setenv("GAMERPAL_LOG_DIR", tmpDir)
@@ -178,3 +200,53 @@ func TestScamGuardAccessors(t *testing.T) {
 		require.Equal(t, "SCAMLOG", cfg.GetScamGuardLogChannelID())
 	})
 }
+
+func TestPruneReportInterval(t *testing.T) {
+	tests := []struct {
+		raw  any
+		want time.Duration
+	}{
+		{nil, 168 * time.Hour},
+		{"24h", 24 * time.Hour},
+		{"10m", time.Hour},
+		{"-1h", 168 * time.Hour},
+	}
+	for _, tt := range tests {
+		kv := map[string]any{}
+		if tt.raw != nil {
+			kv["prune_report_interval"] = tt.raw
+		}
+		cfg := NewMockConfig(kv)
+		require.Equal(t, tt.want, cfg.PrimaryGuild().GetPruneReportInterval(), "raw=%v", tt.raw)
+	}
+}
+
+func TestCommandCooldowns(t *testing.T) {
+	tests := []struct {
+		raw  any
+		want time.Duration
+	}{
+		{nil, 2 * time.Minute},
+		{"10m", 10 * time.Minute},
+		{"0s", 0},
+		{"-1m", 0},
+	}
+	for _, tt := range tests {
+		kv := map[string]any{}
+		if tt.raw != nil {
+			kv["prune_cooldown"] = tt.raw
+		}
+		cfg := NewMockConfig(kv)
+		require.Equal(t, tt.want, cfg.PrimaryGuild().GetPruneCooldown(), "raw=%v", tt.raw)
+	}
+}
+
+func TestLFGForumChannelIDs(t *testing.T) {
+	cfg := NewMockConfig(map[string]any{
+		"gamerpals_lfg_forum_channel_id": "main",
+		"lfg_extra_forum_channel_ids":    "console, main, ,pc,console",
+	})
+	require.Equal(t, []string{"main", "console", "pc"}, cfg.GetLFGForumChannelIDs())
+
+	require.Nil(t, NewMockConfig(nil).GetLFGForumChannelIDs())
+}
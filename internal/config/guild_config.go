@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -195,6 +196,15 @@ func (gc *GuildConfig) resolveDuration(key string) time.Duration {
 	return gc.v.GetDuration(key)
 }
 
+// resolveCooldown returns the command cooldown at key, or def when the key is
+// unset. An explicit value <= 0 disables the cooldown.
+func (gc *GuildConfig) resolveCooldown(key string, def time.Duration) time.Duration {
+	if _, ok := gc.override(key); !ok && !gc.v.IsSet(key) {
+		return def
+	}
+	return max(gc.resolveDuration(key), 0)
+}
+
 // Shared server channels / categories
 // -----
 
@@ -246,6 +256,33 @@ func (gc *GuildConfig) GetIntroFeedBoosterRateLimitHours() int {
 	return hours
 }
 
+// GetIntroLegacyChannelID returns the pre-forum text channel /intro falls back
+// to scanning when a member has no forum post. Empty disables the fallback.
+func (gc *GuildConfig) GetIntroLegacyChannelID() string {
+	return gc.resolveString(KeyIntroLegacyChannelID)
+}
+
+// GetIntroGreeterChannelID returns where new intros are announced to greeters.
+// Empty disables greeter notifications.
+func (gc *GuildConfig) GetIntroGreeterChannelID() string {
+	return gc.resolveString(KeyIntroGreeterChannelID)
+}
+
+// GetIntroGreeterRoleID returns the role pinged for new intros. Empty posts
+// the notification without a ping.
+func (gc *GuildConfig) GetIntroGreeterRoleID() string {
+	return gc.resolveString(KeyIntroGreeterRoleID)
+}
+
+// GetIntroGreeterDebounce returns how long repeat intros from the same member
+// are suppressed. A value <= 0 (or unset) means 10 minutes.
+func (gc *GuildConfig) GetIntroGreeterDebounce() time.Duration {
+	if d := gc.resolveDuration(KeyIntroGreeterDebounce); d > 0 {
+		return d
+	}
+	return 10 * time.Minute
+}
+
 // LFG
 // -----
 
@@ -253,6 +290,19 @@ func (gc *GuildConfig) GetGamerPalsLFGForumChannelID() string {
 	return gc.resolveString(KeyLFGForumChannelID)
 }
 
+// GetLFGForumChannelIDs returns every LFG forum thread searches span: the
+// main LFG forum (where new threads are created) first, then any extra
+// forums, without duplicates.
+func (gc *GuildConfig) GetLFGForumChannelIDs() []string {
+	var out []string
+	for _, id := range append([]string{gc.GetGamerPalsLFGForumChannelID()}, splitTrimCSV(gc.resolveString(KeyLFGExtraForumIDs))...) {
+		if id != "" && !slices.Contains(out, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
 func (gc *GuildConfig) GetLFGNowPanelChannelID() string {
 	return gc.resolveString(KeyLFGNowPanelChannelID)
 }
@@ -265,6 +315,109 @@ func (gc *GuildConfig) GetLFGNowRoleDuration() time.Duration {
 	return gc.resolveDuration(KeyLFGNowRoleDuration)
 }
 
+// GetLFGPingRoleID returns the role mentioned by in-thread /lfg now posts.
+// Empty means fall back to @here.
+func (gc *GuildConfig) GetLFGPingRoleID() string {
+	return gc.resolveString(KeyLFGPingRoleID)
+}
+
+// GetLFGPingCooldown returns how long a user must wait before pinging the same
+// thread again via /lfg now. A value <= 0 (or unset) means the 30-minute default.
+func (gc *GuildConfig) GetLFGPingCooldown() time.Duration {
+	if d := gc.resolveDuration(KeyLFGPingCooldown); d > 0 {
+		return d
+	}
+	return 30 * time.Minute
+}
+
+// GetLFGNowPostExpiry returns how long a Looking NOW post stays active before
+// it is marked "no longer looking". A value <= 0 (or unset) means 45 minutes.
+func (gc *GuildConfig) GetLFGNowPostExpiry() time.Duration {
+	if d := gc.resolveDuration(KeyLFGNowPostExpiry); d > 0 {
+		return d
+	}
+	return 45 * time.Minute
+}
+
+// GetLFGVoiceCategoryID returns the category temporary /lfg now voice channels
+// are created under. Empty disables the create_voice option.
+func (gc *GuildConfig) GetLFGVoiceCategoryID() string {
+	return gc.resolveString(KeyLFGVoiceCategoryID)
+}
+
+// GetLFGVoiceEmptyGrace returns how long a temporary LFG voice channel may sit
+// empty before it is deleted. A value <= 0 (or unset) means 5 minutes.
+func (gc *GuildConfig) GetLFGVoiceEmptyGrace() time.Duration {
+	if d := gc.resolveDuration(KeyLFGVoiceEmptyGrace); d > 0 {
+		return d
+	}
+	return 5 * time.Minute
+}
+
+// GetLFGThreadCreateLimit returns how many LFG threads a non-moderator may
+// create per window. A value <= 0 (or unset) means 3.
+func (gc *GuildConfig) GetLFGThreadCreateLimit() int {
+	n, ok := gc.resolveInt(KeyLFGThreadCreateLimit)
+	if !ok || n <= 0 {
+		return 3
+	}
+	return n
+}
+
+// GetLFGMaxSuggestions returns how many IGDB suggestions the "Create a thread"
+// flow can page through. Unset or non-positive means 25; values are clamped
+// to 5..50.
+func (gc *GuildConfig) GetLFGMaxSuggestions() int {
+	n, ok := gc.resolveInt(KeyLFGMaxSuggestions)
+	if !ok || n <= 0 {
+		return 25
+	}
+	return min(max(n, 5), 50)
+}
+
+// LFGThreadAutoArchiveOptions are the auto-archive durations (minutes) Discord
+// accepts for threads.
+var LFGThreadAutoArchiveOptions = []int{60, 1440, 4320, 10080}
+
+// GetLFGThreadAutoArchive returns the auto-archive duration in minutes for
+// LFG threads the bot creates. Anything other than a value Discord accepts
+// means 4320 (3 days).
+func (gc *GuildConfig) GetLFGThreadAutoArchive() int {
+	n, ok := gc.resolveInt(KeyLFGThreadAutoArchive)
+	if ok && slices.Contains(LFGThreadAutoArchiveOptions, n) {
+		return n
+	}
+	return 4320
+}
+
+// GetLFGPinStarterMessage reports whether the bot pins the game info message
+// it posts when creating an LFG thread.
+func (gc *GuildConfig) GetLFGPinStarterMessage() bool {
+	return gc.resolveBool(KeyLFGPinStarterMessage)
+}
+
+// GetLFGThreadCreateWindow returns the sliding window for the LFG thread
+// creation limit. A value <= 0 (or unset) means 10 minutes.
+func (gc *GuildConfig) GetLFGThreadCreateWindow() time.Duration {
+	if d := gc.resolveDuration(KeyLFGThreadCreateWin); d > 0 {
+		return d
+	}
+	return 10 * time.Minute
+}
+
+// GetLFGGameThreadCooldown returns how long a user waits between /game-thread
+// runs. Unset means 15 seconds; a value <= 0 disables the cooldown.
+func (gc *GuildConfig) GetLFGGameThreadCooldown() time.Duration {
+	return gc.resolveCooldown(KeyLFGGameThreadCooldown, 15*time.Second)
+}
+
+// GetLFGRefreshCacheCooldown returns how long the guild waits between
+// /lfg-admin refresh-thread-cache runs. Unset means 5 minutes; a value <= 0
+// disables the cooldown.
+func (gc *GuildConfig) GetLFGRefreshCacheCooldown() time.Duration {
+	return gc.resolveCooldown(KeyLFGRefreshCacheCooldown, 5*time.Minute)
+}
+
 // New Pals
 // -----
 
@@ -307,6 +460,90 @@ func (gc *GuildConfig) GetTranslateLanguage() string {
 	return "random"
 }
 
+// Account age gate
+// -----
+
+// GetAccountAgeGateEnabled reports whether new accounts/members are blocked
+// from public commands.
+func (gc *GuildConfig) GetAccountAgeGateEnabled() bool {
+	return gc.resolveBool(KeyAccountAgeGateEnabled)
+}
+
+// GetAccountAgeGateMinAccountAge returns the minimum Discord account age. A
+// value <= 0 disables the account age check.
+func (gc *GuildConfig) GetAccountAgeGateMinAccountAge() time.Duration {
+	return max(gc.resolveDuration(KeyAccountAgeGateMinAccountAge), 0)
+}
+
+// GetAccountAgeGateMinMemberAge returns how long someone must have been in the
+// server. A value <= 0 disables the join age check.
+func (gc *GuildConfig) GetAccountAgeGateMinMemberAge() time.Duration {
+	return max(gc.resolveDuration(KeyAccountAgeGateMinMemberAge), 0)
+}
+
+// GetAccountAgeGateExemptCommands returns command names (without the leading
+// slash) that new accounts may always use.
+func (gc *GuildConfig) GetAccountAgeGateExemptCommands() []string {
+	names := splitTrimCSV(gc.resolveString(KeyAccountAgeGateExemptCmds))
+	for idx, n := range names {
+		names[idx] = strings.TrimPrefix(n, "/")
+	}
+	return names
+}
+
+// Prune
+// -----
+
+// GetPruneProtectedRoleIDs returns roles that exempt a member from
+// prune-inactive no matter what other roles they hold.
+func (gc *GuildConfig) GetPruneProtectedRoleIDs() []string {
+	return splitTrimCSV(gc.resolveString(KeyPruneProtectedRoleIDs))
+}
+
+// GetPruneCountedRoleIDs returns the roles that count toward a member being
+// active for prune-inactive. Empty means any role counts.
+func (gc *GuildConfig) GetPruneCountedRoleIDs() []string {
+	return splitTrimCSV(gc.resolveString(KeyPruneCountedRoleIDs))
+}
+
+// GetPruneReportEnabled reports whether the scheduled dry-run prune report is
+// posted to the log channel.
+func (gc *GuildConfig) GetPruneReportEnabled() bool {
+	return gc.resolveBool(KeyPruneReportEnabled)
+}
+
+// GetPruneReportInterval returns how often the prune report is posted,
+// defaulting to 168h (weekly) when unset or non-positive. The report is
+// checked hourly, so anything shorter than an hour is raised to 1h.
+func (gc *GuildConfig) GetPruneReportInterval() time.Duration {
+	d := gc.resolveDuration(KeyPruneReportInterval)
+	if d <= 0 {
+		return 168 * time.Hour
+	}
+	return max(d, time.Hour)
+}
+
+// GetPruneCooldown returns how long the guild waits between /prune-inactive or
+// /prune-forum runs. Unset means 2 minutes; a value <= 0 disables the cooldown.
+func (gc *GuildConfig) GetPruneCooldown() time.Duration {
+	return gc.resolveCooldown(KeyPruneCooldown, 2*time.Minute)
+}
+
+// User stats
+// -----
+
+// GetUserStatsCooldown returns how long a user waits between /userstats runs.
+// Unset means 30 seconds; a value <= 0 disables the cooldown.
+func (gc *GuildConfig) GetUserStatsCooldown() time.Duration {
+	return gc.resolveCooldown(KeyUserStatsCooldown, 30*time.Second)
+}
+
+// GetExtraSuperAdminIDs returns the users granted super admin on top of the
+// bootstrap super_admins list. Only the primary guild's value is consulted.
+func (gc *GuildConfig) GetExtraSuperAdminIDs() []string {
+	return splitTrimCSV(gc.resolveString(KeyExtraSuperAdminIDs))
+}
+
 // ScamGuard
 // -----
 
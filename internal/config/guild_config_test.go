@@ -101,6 +101,13 @@ func TestGuildConfigResolution(t *testing.T) {
 
 		require.NoError(t, cfg.ForGuild(guild).SetOverride(KeyScamGuardAction, "explode", "U1"))
 		require.Equal(t, "timeout", cfg.GetScamGuardAction(), "invalid action falls back to timeout")
+
+		gc := cfg.ForGuild(guild)
+		require.Equal(t, 4320, gc.GetLFGThreadAutoArchive(), "unset auto-archive defaults to 3 days")
+		require.NoError(t, gc.SetOverride(KeyLFGThreadAutoArchive, "10080", "U1"))
+		require.Equal(t, 10080, gc.GetLFGThreadAutoArchive())
+		require.NoError(t, gc.SetOverride(KeyLFGThreadAutoArchive, "720", "U1"))
+		require.Equal(t, 4320, gc.GetLFGThreadAutoArchive(), "values Discord rejects fall back to 3 days")
 	})
 
 	t.Run("unparseable override falls back to env/default", func(t *testing.T) {
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ansiPattern matches terminal color escape sequences the log renderer may
+// emit so they don't break level/caller parsing.
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// LogTally is an io.Writer placed alongside the regular log outputs that
+// counts warn/error lines by caller. It never fails a write, so it can't
+// interfere with normal logging.
+type LogTally struct {
+	mu       sync.Mutex
+	warnings int
+	errors   int
+	byCaller map[string]int
+}
+
+// LogDigest is a point-in-time summary of tallied warn/error lines.
+type LogDigest struct {
+	Warnings int
+	Errors   int
+	// TopCallers is sorted by count descending, then caller ascending.
+	TopCallers []CallerCount
+}
+
+// CallerCount is how many warn/error lines a single call site produced.
+type CallerCount struct {
+	Caller string
+	Count  int
+}
+
+// NewLogTally creates an empty tally.
+func NewLogTally() *LogTally {
+	return &LogTally{byCaller: make(map[string]int)}
+}
+
+// Write inspects a single formatted log entry. charmbracelet/log writes one
+// entry per call in the form "<time> <LEVEL> <caller> message ...".
+func (t *LogTally) Write(p []byte) (int, error) {
+	line, _, _ := strings.Cut(string(p), "\n")
+	fields := strings.Fields(ansiPattern.ReplaceAllString(line, ""))
+
+	level := ""
+	caller := "unknown"
+	for idx, f := range fields {
+		if idx > 3 {
+			break
+		}
+		switch f {
+		case "WARN":
+			level = "warn"
+		case "ERRO", "FATA":
+			level = "error"
+		}
+		if strings.HasPrefix(f, "<") && strings.HasSuffix(f, ">") {
+			caller = strings.Trim(f, "<>")
+		}
+	}
+	if level == "" {
+		return len(p), nil
+	}
+
+	t.mu.Lock()
+	if level == "warn" {
+		t.warnings++
+	} else {
+		t.errors++
+	}
+	t.byCaller[caller]++
+	t.mu.Unlock()
+	return len(p), nil
+}
+
+// Snapshot returns the current tally and resets it, limiting TopCallers to
+// the given number of entries (0 means no limit).
+func (t *LogTally) Snapshot(top int) LogDigest {
+	t.mu.Lock()
+	d := LogDigest{Warnings: t.warnings, Errors: t.errors}
+	for caller, n := range t.byCaller {
+		d.TopCallers = append(d.TopCallers, CallerCount{Caller: caller, Count: n})
+	}
+	t.warnings, t.errors = 0, 0
+	t.byCaller = make(map[string]int)
+	t.mu.Unlock()
+
+	sort.Slice(d.TopCallers, func(i, j int) bool {
+		if d.TopCallers[i].Count == d.TopCallers[j].Count {
+			return d.TopCallers[i].Caller < d.TopCallers[j].Caller
+		}
+		return d.TopCallers[i].Count > d.TopCallers[j].Count
+	})
+	if top > 0 && len(d.TopCallers) > top {
+		d.TopCallers = d.TopCallers[:top]
+	}
+	return d
+}
+
+// Empty reports whether no warnings or errors were tallied.
+func (d LogDigest) Empty() bool {
+	return d.Warnings == 0 && d.Errors == 0
+}
+
+// String renders the digest as a short log-channel message.
+func (d LogDigest) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[Hourly Log Digest]\nWarnings: %d\nErrors: %d", d.Warnings, d.Errors)
+	if len(d.TopCallers) > 0 {
+		b.WriteString("\nTop offenders:")
+		for _, c := range d.TopCallers {
+			fmt.Fprintf(&b, "\n• `%s` — %d", c.Caller, c.Count)
+		}
+	}
+	return b.String()
+}
+
+// LogTally returns the warn/error tally attached to the logger, or nil when
+// the config was not built via NewConfig (e.g. mock configs in tests).
+func (c *Config) LogTally() *LogTally {
+	return c.logTally
+}
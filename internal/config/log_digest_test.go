@@ -0,0 +1,30 @@
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogTally(t *testing.T) {
+	tally := NewLogTally()
+	logger := log.NewWithOptions(&bytes.Buffer{}, log.Options{ReportCaller: true, ReportTimestamp: true})
+	logger.SetOutput(tally)
+
+	logger.Info("just chatting")
+	logger.Warn("first warning")
+	logger.Warn("second warning")
+	logger.Error("boom")
+
+	d := tally.Snapshot(5)
+	require.Equal(t, 2, d.Warnings)
+	require.Equal(t, 1, d.Errors)
+	require.NotEmpty(t, d.TopCallers)
+	require.Contains(t, d.TopCallers[0].Caller, "log_digest_test.go")
+	require.Contains(t, d.String(), "Warnings: 2")
+
+	// Snapshot resets the tally
+	require.True(t, tally.Snapshot(5).Empty())
+}
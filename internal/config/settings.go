@@ -20,11 +20,28 @@ const (
 	KeyIntroFeedChannelID          = "intro_feed_channel_id"
 	KeyIntroFeedRateLimitHours     = "intro_feed_rate_limit_hours"
 	KeyIntroFeedBoosterRateLimit   = "intro_feed_booster_rate_limit_hours"
-
-	KeyLFGForumChannelID    = "gamerpals_lfg_forum_channel_id"
-	KeyLFGNowPanelChannelID = "gamerpals_lfg_now_panel_channel_id"
-	KeyLFGNowRoleID         = "lfg_now_role_id"
-	KeyLFGNowRoleDuration   = "lfg_now_role_duration"
+	KeyIntroLegacyChannelID        = "intro_legacy_channel_id"
+	KeyIntroGreeterChannelID       = "intro_greeter_channel_id"
+	KeyIntroGreeterRoleID          = "intro_greeter_role_id"
+	KeyIntroGreeterDebounce        = "intro_greeter_debounce"
+
+	KeyLFGForumChannelID       = "gamerpals_lfg_forum_channel_id"
+	KeyLFGExtraForumIDs        = "lfg_extra_forum_channel_ids"
+	KeyLFGNowPanelChannelID    = "gamerpals_lfg_now_panel_channel_id"
+	KeyLFGNowRoleID            = "lfg_now_role_id"
+	KeyLFGNowRoleDuration      = "lfg_now_role_duration"
+	KeyLFGPingRoleID           = "lfg_ping_role_id"
+	KeyLFGPingCooldown         = "lfg_ping_cooldown"
+	KeyLFGNowPostExpiry        = "lfg_now_post_expiry"
+	KeyLFGVoiceCategoryID      = "lfg_voice_category_id"
+	KeyLFGVoiceEmptyGrace      = "lfg_voice_empty_grace"
+	KeyLFGThreadCreateLimit    = "lfg_thread_create_limit"
+	KeyLFGThreadCreateWin      = "lfg_thread_create_window"
+	KeyLFGGameThreadCooldown   = "lfg_game_thread_cooldown"
+	KeyLFGRefreshCacheCooldown = "lfg_refresh_cache_cooldown"
+	KeyLFGMaxSuggestions       = "lfg_max_suggestions"
+	KeyLFGThreadAutoArchive    = "lfg_thread_auto_archive_minutes"
+	KeyLFGPinStarterMessage    = "lfg_pin_starter_message"
 
 	KeyNewPalsSystemEnabled    = "new_pals_system_enabled"
 	KeyNewPalsRoleID           = "new_pals_role_id"
@@ -36,6 +53,21 @@ const (
 
 	KeyTranslateLanguage = "translate_language"
 
+	KeyAccountAgeGateEnabled       = "account_age_gate_enabled"
+	KeyAccountAgeGateMinAccountAge = "account_age_gate_min_account_age"
+	KeyAccountAgeGateMinMemberAge  = "account_age_gate_min_member_age"
+	KeyAccountAgeGateExemptCmds    = "account_age_gate_exempt_commands"
+
+	KeyExtraSuperAdminIDs = "extra_super_admin_ids"
+
+	KeyPruneProtectedRoleIDs = "prune_protected_role_ids"
+	KeyPruneCountedRoleIDs   = "prune_counted_role_ids"
+	KeyPruneReportEnabled    = "prune_report_enabled"
+	KeyPruneReportInterval   = "prune_report_interval"
+	KeyPruneCooldown         = "prune_cooldown"
+
+	KeyUserStatsCooldown = "userstats_cooldown"
+
 	KeyScamGuardEnabled         = "scamguard_enabled"
 	KeyScamGuardHashThreshold   = "scamguard_hash_threshold"
 	KeyScamGuardAction          = "scamguard_action"
@@ -62,6 +94,8 @@ const (
 	KindCategory    Kind = "category"     // a single category channel ID
 	KindRole        Kind = "role"         // a single role ID
 	KindChannelList Kind = "channel_list" // a CSV list of channel IDs
+	KindRoleList    Kind = "role_list"    // a CSV list of role IDs
+	KindUserList    Kind = "user_list"    // a CSV list of user IDs
 	KindBool        Kind = "bool"         // a toggle
 	KindEnum        Kind = "enum"         // one of EnumOptions
 	KindInt         Kind = "int"          // an integer entered via modal
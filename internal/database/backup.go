@@ -0,0 +1,19 @@
+package database
+
+import (
+	"fmt"
+	"os"
+)
+
+// Backup writes a consistent copy of the database to destPath using SQLite's
+// VACUUM INTO, which reads from a single snapshot so concurrent writes can't
+// tear the copy. destPath must not already exist.
+func (db *DB) Backup(destPath string) error {
+	if _, err := os.Stat(destPath); err == nil {
+		return fmt.Errorf("failed to back up database: %s already exists", destPath)
+	}
+	if _, err := db.conn.Exec(`VACUUM INTO ?`, destPath); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// command_usage records one row per slash/context command invocation so admins
+// can see which commands are used and which are crashing. success is false
+// only when the handler panicked; handlers have no way to report other
+// failures.
+
+// CommandUsageStat summarizes invocations of a single command.
+type CommandUsageStat struct {
+	CommandName string `json:"command_name"`
+	Uses        int    `json:"uses"`
+	Panics      int    `json:"panics"`
+}
+
+// RecordCommandUsage stores a single command invocation.
+func (db *DB) RecordCommandUsage(commandName, guildID, userID string, success bool) error {
+	_, err := db.conn.Exec(
+		`INSERT INTO command_usage (command_name, guild_id, user_id, success) VALUES (?, ?, ?, ?)`,
+		commandName, guildID, userID, success,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record usage of command %s: %w", commandName, err)
+	}
+	return nil
+}
+
+// GetCommandUsageSince returns per-command usage since the given time, most
+// used first (ties broken by name).
+func (db *DB) GetCommandUsageSince(since time.Time) ([]CommandUsageStat, error) {
+	// Format to match SQLite's CURRENT_TIMESTAMP format for reliable comparison
+	sinceStr := since.UTC().Format("2006-01-02 15:04:05")
+	rows, err := db.conn.Query(
+		`SELECT command_name, COUNT(*), SUM(CASE WHEN success THEN 0 ELSE 1 END)
+		 FROM command_usage
+		 WHERE used_at >= ?
+		 GROUP BY command_name
+		 ORDER BY COUNT(*) DESC, command_name`,
+		sinceStr,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query command usage: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []CommandUsageStat
+	for rows.Next() {
+		var s CommandUsageStat
+		if err := rows.Scan(&s.CommandName, &s.Uses, &s.Panics); err != nil {
+			return nil, fmt.Errorf("failed to scan command usage: %w", err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate command usage: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteCommandUsageBefore removes invocations recorded before the given time
+// and returns how many rows were deleted.
+func (db *DB) DeleteCommandUsageBefore(before time.Time) (int64, error) {
+	// Format to match SQLite's CURRENT_TIMESTAMP format for reliable comparison
+	res, err := db.conn.Exec(`DELETE FROM command_usage WHERE used_at < ?`, before.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune command usage: %w", err)
+	}
+	return res.RowsAffected()
+}
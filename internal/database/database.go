@@ -15,13 +15,30 @@ type DB struct {
 	conn *sql.DB
 }
 
-// buildDSN augments a SQLite file path with connection parameters that let the
-// database work on network-backed volumes (Azure Files / SMB), where the POSIX
-// byte-range locking SQLite uses by default is unavailable and every write
-// otherwise fails with "database is locked". unix-dotfile locking uses a
-// companion lock file instead, and a busy timeout absorbs brief contention.
-// In-memory databases are returned unchanged.
-func buildDSN(dbPath string) string {
+// Options tunes how the SQLite connection is opened.
+type Options struct {
+	// BusyTimeout is how long a statement waits for a lock held by another
+	// connection or process before failing with "database is locked".
+	BusyTimeout time.Duration
+	// WAL switches to write-ahead logging, which lets reads proceed while a
+	// write is in flight. WAL needs shared memory that network filesystems
+	// (Azure Files / SMB) can't provide, so enable it only for local disks.
+	WAL bool
+}
+
+// DefaultOptions are used by NewDB: a 5s busy timeout and the rollback
+// journal, which is safe on network-backed volumes.
+var DefaultOptions = Options{BusyTimeout: 5 * time.Second}
+
+// buildDSN augments a SQLite file path with connection parameters. By default
+// the database is set up to work on network-backed volumes (Azure Files /
+// SMB), where the POSIX byte-range locking SQLite uses by default is
+// unavailable and every write otherwise fails with "database is locked".
+// unix-dotfile locking uses a companion lock file instead, and a busy timeout
+// absorbs brief contention. With WAL, the default locking is kept because the
+// dot-file VFS has no shared-memory support. In-memory databases are returned
+// unchanged.
+func buildDSN(dbPath string, opts Options) string {
 	if dbPath == "" || dbPath == ":memory:" || strings.HasPrefix(dbPath, "file::memory:") {
 		return dbPath
 	}
@@ -29,28 +46,45 @@ func buildDSN(dbPath string) string {
 	if strings.ContainsRune(dbPath, '?') {
 		sep = "&"
 	}
-	return dbPath + sep + "vfs=unix-dotfile&_busy_timeout=5000"
+	params := fmt.Sprintf("_busy_timeout=%d", opts.BusyTimeout.Milliseconds())
+	if opts.WAL {
+		params = "_journal_mode=WAL&" + params
+	} else {
+		params = "vfs=unix-dotfile&" + params
+	}
+	return dbPath + sep + params
 }
 
-// NewDB creates a new database connection and initializes tables
+// NewDB creates a new database connection with DefaultOptions and brings the
+// schema up to date.
 func NewDB(dbPath string) (*DB, error) {
-	conn, err := sql.Open("sqlite3", buildDSN(dbPath))
+	return NewDBWithOptions(dbPath, DefaultOptions)
+}
+
+// NewDBWithOptions creates a new database connection with the given options
+// and brings the schema up to date.
+func NewDBWithOptions(dbPath string, opts Options) (*DB, error) {
+	conn, err := sql.Open("sqlite3", buildDSN(dbPath, opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
 	// SQLite allows only one writer. Serializing access through a single
-	// connection avoids intra-process "database is locked" contention, which
-	// matters most on network-backed volumes where the dot-file locking from
-	// buildDSN is coarse. It also keeps in-memory test databases consistent,
-	// since each sqlite3 connection to ":memory:" is otherwise distinct.
+	// long-lived connection avoids intra-process "database is locked"
+	// contention, which matters most on network-backed volumes where the
+	// dot-file locking from buildDSN is coarse. Concurrent callers queue for
+	// the connection instead of racing for the lock. It also keeps in-memory
+	// test databases consistent, since each sqlite3 connection to ":memory:"
+	// is otherwise distinct.
 	conn.SetMaxOpenConns(1)
+	conn.SetMaxIdleConns(1)
+	conn.SetConnMaxLifetime(0)
 
 	db := &DB{conn: conn}
 
-	// Initialize tables
-	if err := db.initTables(); err != nil {
-		return nil, fmt.Errorf("failed to initialize tables: %w", err)
+	// Bring the schema up to date
+	if err := db.migrate(); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
 	return db, nil
@@ -61,119 +95,6 @@ func (db *DB) Close() error {
 	return db.conn.Close()
 }
 
-// initTables creates the necessary database tables
-func (db *DB) initTables() error {
-	query := `
-	CREATE TABLE IF NOT EXISTS welcome_messages (
-	    id INTEGER PRIMARY KEY AUTOINCREMENT,
-	    user_id TEXT NOT NULL,
-	    message TEXT NOT NULL,
-	    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-	
-	CREATE INDEX IF NOT EXISTS idx_welcome_messages_user_id ON welcome_messages(user_id);
-
-	CREATE TABLE IF NOT EXISTS intro_feed_posts (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		user_id TEXT NOT NULL,
-		thread_id TEXT NOT NULL,
-		feed_message_id TEXT,
-		is_bump BOOLEAN NOT NULL DEFAULT 0,
-		posted_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_user_id ON intro_feed_posts(user_id);
-	CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_posted_at ON intro_feed_posts(posted_at);
-
-	CREATE TABLE IF NOT EXISTS introduction_threads (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		thread_id TEXT NOT NULL UNIQUE,
-		user_id TEXT NOT NULL,
-		username TEXT,
-		thread_title TEXT,
-		first_message_content TEXT,
-		applied_tags TEXT DEFAULT '[]',
-		created_at DATETIME,
-		fetched_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_introduction_threads_user_id ON introduction_threads(user_id);
-	CREATE INDEX IF NOT EXISTS idx_introduction_threads_fetched_at ON introduction_threads(fetched_at);
-
-	CREATE TABLE IF NOT EXISTS scam_image_hashes (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		hash TEXT NOT NULL UNIQUE,
-		added_by TEXT,
-		source TEXT,
-		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_scam_image_hashes_hash ON scam_image_hashes(hash);
-
-	CREATE TABLE IF NOT EXISTS guild_config (
-		guild_id   TEXT NOT NULL,
-		key        TEXT NOT NULL,
-		value      TEXT NOT NULL,
-		updated_by TEXT,
-		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-		PRIMARY KEY (guild_id, key)
-	);
-	`
-
-	_, err := db.conn.Exec(query)
-	if err != nil {
-		return err
-	}
-
-	// One-time migration: recreate intro_feed_posts if it has the old schema
-	// (missing is_bump column due to UNIQUE(thread_id) constraint).
-	var hasIsBump bool
-	rows, err := db.conn.Query(`PRAGMA table_info(intro_feed_posts)`)
-	if err != nil {
-		return fmt.Errorf("failed to check intro_feed_posts schema: %w", err)
-	}
-	defer rows.Close()
-	for rows.Next() {
-		var cid int
-		var name, ctype string
-		var notnull int
-		var dfltValue *string
-		var pk int
-		if err := rows.Scan(&cid, &name, &ctype, &notnull, &dfltValue, &pk); err != nil {
-			return fmt.Errorf("failed to scan table_info: %w", err)
-		}
-		if name == "is_bump" {
-			hasIsBump = true
-			break
-		}
-	}
-	if !hasIsBump {
-		// Old schema: drop and let next startup recreate with new schema
-		if _, err := db.conn.Exec(`DROP TABLE intro_feed_posts`); err != nil {
-			return fmt.Errorf("failed to drop old intro_feed_posts table: %w", err)
-		}
-		if _, err := db.conn.Exec(`
-			CREATE TABLE intro_feed_posts (
-				id INTEGER PRIMARY KEY AUTOINCREMENT,
-				user_id TEXT NOT NULL,
-				thread_id TEXT NOT NULL,
-				feed_message_id TEXT,
-				is_bump BOOLEAN NOT NULL DEFAULT 0,
-				posted_at DATETIME DEFAULT CURRENT_TIMESTAMP
-			)`); err != nil {
-			return fmt.Errorf("failed to recreate intro_feed_posts table: %w", err)
-		}
-		if _, err := db.conn.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_user_id ON intro_feed_posts(user_id);
-			CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_posted_at ON intro_feed_posts(posted_at);
-		`); err != nil {
-			return fmt.Errorf("failed to recreate intro_feed_posts indexes: %w", err)
-		}
-	}
-
-	return nil
-}
-
 func (db *DB) SetWelcomeMessage(userId string, message string) error {
 	currentMsg, err := db.GetWelcomeMessage()
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
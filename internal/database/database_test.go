@@ -1,8 +1,11 @@
 package database
 
 import (
+	"fmt"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -17,16 +20,57 @@ func newTestDB(t *testing.T) *DB {
 
 func TestBuildDSN(t *testing.T) {
 	// File paths get dot-file locking + busy timeout appended.
-	require.Equal(t, "/data/gamerpal.db?vfs=unix-dotfile&_busy_timeout=5000", buildDSN("/data/gamerpal.db"))
-	require.Equal(t, "./gamerpal.db?vfs=unix-dotfile&_busy_timeout=5000", buildDSN("./gamerpal.db"))
+	require.Equal(t, "/data/gamerpal.db?vfs=unix-dotfile&_busy_timeout=5000", buildDSN("/data/gamerpal.db", DefaultOptions))
+	require.Equal(t, "./gamerpal.db?vfs=unix-dotfile&_busy_timeout=5000", buildDSN("./gamerpal.db", DefaultOptions))
 
 	// An existing query string is extended, not clobbered.
-	require.Equal(t, "/data/gamerpal.db?cache=shared&vfs=unix-dotfile&_busy_timeout=5000", buildDSN("/data/gamerpal.db?cache=shared"))
+	require.Equal(t, "/data/gamerpal.db?cache=shared&vfs=unix-dotfile&_busy_timeout=5000", buildDSN("/data/gamerpal.db?cache=shared", DefaultOptions))
+
+	// WAL keeps the default locking and honors a custom busy timeout.
+	require.Equal(t, "./gamerpal.db?_journal_mode=WAL&_busy_timeout=250", buildDSN("./gamerpal.db", Options{BusyTimeout: 250 * time.Millisecond, WAL: true}))
 
 	// In-memory databases are left untouched.
-	require.Equal(t, ":memory:", buildDSN(":memory:"))
-	require.Equal(t, "file::memory:?cache=shared", buildDSN("file::memory:?cache=shared"))
-	require.Equal(t, "", buildDSN(""))
+	require.Equal(t, ":memory:", buildDSN(":memory:", DefaultOptions))
+	require.Equal(t, "file::memory:?cache=shared", buildDSN("file::memory:?cache=shared", DefaultOptions))
+	require.Equal(t, "", buildDSN("", DefaultOptions))
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		opts Options
+	}{
+		{"rollback journal", DefaultOptions},
+		{"wal", Options{BusyTimeout: 5 * time.Second, WAL: true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := NewDBWithOptions(filepath.Join(t.TempDir(), "test.db"), tc.opts)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = db.Close() })
+
+			const writers, perWriter = 8, 25
+			var wg sync.WaitGroup
+			errs := make(chan error, writers*perWriter)
+			for w := range writers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for range perWriter {
+						errs <- db.RecordCommandUsage("ping", "g1", fmt.Sprintf("u%d", w), true)
+					}
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				require.NoError(t, err)
+			}
+
+			stats, err := db.GetCommandUsageSince(time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, []CommandUsageStat{{CommandName: "ping", Uses: writers * perWriter}}, stats)
+		})
+	}
 }
 
 func TestScamImageHashes_AddListDedupeRemove(t *testing.T) {
@@ -64,3 +108,183 @@ func TestScamImageHashes_AddListDedupeRemove(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, removed)
 }
+
+func TestLFGTempVoiceChannels_AddListRemove(t *testing.T) {
+	db := newTestDB(t)
+
+	require.NoError(t, db.AddLFGTempVoiceChannel("vc1", "g1", "u1"))
+	require.NoError(t, db.AddLFGTempVoiceChannel("vc2", "g1", "u2"))
+	// Re-adding the same channel is a no-op.
+	require.NoError(t, db.AddLFGTempVoiceChannel("vc1", "g1", "u1"))
+
+	chans, err := db.ListLFGTempVoiceChannels()
+	require.NoError(t, err)
+	require.Len(t, chans, 2)
+	require.Equal(t, "u1", chans[0].OwnerID)
+
+	require.NoError(t, db.RemoveLFGTempVoiceChannel("vc1"))
+	require.NoError(t, db.RemoveLFGTempVoiceChannel("missing"))
+	chans, err = db.ListLFGTempVoiceChannels()
+	require.NoError(t, err)
+	require.Len(t, chans, 1)
+	require.Equal(t, "vc2", chans[0].ChannelID)
+}
+
+func TestPruneAllowlist_AddListRemove(t *testing.T) {
+	db := newTestDB(t)
+
+	added, err := db.AddPruneAllowlistThread("g1", "t1", "mod1", "starter reposted")
+	require.NoError(t, err)
+	require.True(t, added)
+	added, err = db.AddPruneAllowlistThread("g1", "t1", "mod2", "")
+	require.NoError(t, err)
+	require.False(t, added, "re-adding keeps the original entry")
+	_, err = db.AddPruneAllowlistThread("g1", "t2", "mod1", "")
+	require.NoError(t, err)
+	_, err = db.AddPruneAllowlistThread("g2", "t3", "mod1", "")
+	require.NoError(t, err)
+
+	entries, err := db.ListPruneAllowlist("g1")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "mod1", entries[0].AddedBy)
+	require.Equal(t, "starter reposted", entries[0].Note)
+
+	removed, err := db.RemovePruneAllowlistThread("g1", "t1")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = db.RemovePruneAllowlistThread("g1", "t3")
+	require.NoError(t, err)
+	require.False(t, removed, "entries are scoped to their guild")
+
+	entries, err = db.ListPruneAllowlist("g1")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "t2", entries[0].ThreadID)
+}
+
+func TestUserTimezones(t *testing.T) {
+	db := newTestDB(t)
+
+	loc, err := db.GetUserTimezone("u1")
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, loc, "unset falls back to UTC")
+
+	require.Error(t, db.SetUserTimezone("u1", "Not/AZone"))
+	require.NoError(t, db.SetUserTimezone("u1", "America/New_York"))
+	require.NoError(t, db.SetUserTimezone("u1", "Europe/Berlin"))
+	loc, err = db.GetUserTimezone("u1")
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Berlin", loc.String())
+
+	cleared, err := db.ClearUserTimezone("u1")
+	require.NoError(t, err)
+	require.True(t, cleared)
+	cleared, err = db.ClearUserTimezone("u1")
+	require.NoError(t, err)
+	require.False(t, cleared)
+	loc, err = db.GetUserTimezone("u1")
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, loc)
+}
+
+func TestScheduledSays_WriteThrough(t *testing.T) {
+	db := newTestDB(t)
+	later := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	sooner := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	laterID, err := db.InsertScheduledSay(ScheduledSay{ChannelID: "c1", Content: "later", FireAt: later, ScheduledBy: "mod1"})
+	require.NoError(t, err)
+	soonerID, err := db.InsertScheduledSay(ScheduledSay{ChannelID: "c2", Content: "sooner", FireAt: sooner, ScheduledBy: "mod2", SuppressModMessage: true, AllowPings: true, Split: true})
+	require.NoError(t, err)
+	require.NotEqual(t, laterID, soonerID)
+
+	says, err := db.ListScheduledSays()
+	require.NoError(t, err)
+	require.Len(t, says, 2)
+	require.Equal(t, soonerID, says[0].ID)
+	require.Equal(t, "sooner", says[0].Content)
+	require.True(t, says[0].SuppressModMessage)
+	require.True(t, says[0].AllowPings)
+	require.False(t, says[1].AllowPings)
+	require.True(t, says[0].Split)
+	require.True(t, sooner.Equal(says[0].FireAt))
+
+	// Listing doesn't consume the queue; rows stay until fired or cancelled.
+	require.NoError(t, db.UpdateScheduledSay(ScheduledSay{ID: laterID, ChannelID: "c3", Content: "edited", FireAt: sooner.Add(-time.Minute)}))
+	require.NoError(t, db.DeleteScheduledSay(soonerID))
+	says, err = db.ListScheduledSays()
+	require.NoError(t, err)
+	require.Len(t, says, 1)
+	require.Equal(t, laterID, says[0].ID)
+	require.Equal(t, "c3", says[0].ChannelID)
+	require.Equal(t, "edited", says[0].Content)
+	require.Equal(t, "mod1", says[0].ScheduledBy)
+}
+
+func TestJobRuns(t *testing.T) {
+	db := newTestDB(t)
+
+	at, err := db.GetLastJobRun("prune_report", "g1")
+	require.NoError(t, err)
+	require.True(t, at.IsZero())
+
+	first := time.Date(2026, 10, 19, 0, 5, 0, 0, time.UTC)
+	require.NoError(t, db.RecordJobRun("prune_report", "g1", first))
+	require.NoError(t, db.RecordJobRun("prune_report", "g1", first.Add(time.Hour)))
+	at, err = db.GetLastJobRun("prune_report", "g1")
+	require.NoError(t, err)
+	require.True(t, first.Add(time.Hour).Equal(at))
+
+	at, err = db.GetLastJobRun("prune_report", "g2")
+	require.NoError(t, err)
+	require.True(t, at.IsZero(), "runs are tracked per guild")
+}
+
+func TestCommandUsage_RecordAndSummarize(t *testing.T) {
+	db := newTestDB(t)
+
+	require.NoError(t, db.RecordCommandUsage("ping", "g1", "u1", true))
+	require.NoError(t, db.RecordCommandUsage("lfg", "g1", "u1", true))
+	require.NoError(t, db.RecordCommandUsage("lfg", "g1", "u2", false))
+	require.NoError(t, db.RecordCommandUsage("lfg", "g1", "u3", true))
+
+	stats, err := db.GetCommandUsageSince(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []CommandUsageStat{
+		{CommandName: "lfg", Uses: 3, Panics: 1},
+		{CommandName: "ping", Uses: 1, Panics: 0},
+	}, stats)
+
+	stats, err = db.GetCommandUsageSince(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, stats)
+
+	deleted, err := db.DeleteCommandUsageBefore(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, deleted, "recent rows are kept")
+	deleted, err = db.DeleteCommandUsageBefore(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 4, deleted)
+	stats, err = db.GetCommandUsageSince(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
+
+func TestBackup(t *testing.T) {
+	db := newTestDB(t)
+	require.NoError(t, db.SetWelcomeMessage("u1", "hello from before the backup"))
+
+	dest := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, db.Backup(dest))
+
+	restored, err := NewDB(dest)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = restored.Close() })
+	msg, err := restored.GetWelcomeMessage()
+	require.NoError(t, err)
+	require.Equal(t, "hello from before the backup", msg)
+
+	// Refuses to overwrite an existing file.
+	require.Error(t, db.Backup(dest))
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// job_runs records when a periodic job last completed for a guild, so jobs
+// that run on a calendar (weekly reports and the like) don't repeat or skip
+// a period across restarts.
+
+// GetLastJobRun returns when job last ran for guildID, or the zero time if it
+// never has.
+func (db *DB) GetLastJobRun(job, guildID string) (time.Time, error) {
+	var at time.Time
+	err := db.conn.QueryRow(`SELECT last_run FROM job_runs WHERE job = ? AND guild_id = ?`, job, guildID).Scan(&at)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get last %s run for guild %s: %w", job, guildID, err)
+	}
+	return at, nil
+}
+
+// RecordJobRun stores at as the last time job ran for guildID.
+func (db *DB) RecordJobRun(job, guildID string, at time.Time) error {
+	_, err := db.conn.Exec(
+		`INSERT INTO job_runs (job, guild_id, last_run) VALUES (?, ?, ?)
+		 ON CONFLICT(job, guild_id) DO UPDATE SET last_run = excluded.last_run`,
+		job, guildID, at.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record %s run for guild %s: %w", job, guildID, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// lfg_temp_voice_channels tracks voice channels the LFG module created for
+// /lfg now so they can be cleaned up once empty, even across bot restarts.
+
+// LFGTempVoiceChannel is a bot-created temporary voice channel.
+type LFGTempVoiceChannel struct {
+	ChannelID string    `json:"channel_id"`
+	GuildID   string    `json:"guild_id"`
+	OwnerID   string    `json:"owner_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AddLFGTempVoiceChannel records a newly created temporary voice channel.
+func (db *DB) AddLFGTempVoiceChannel(channelID, guildID, ownerID string) error {
+	_, err := db.conn.Exec(
+		`INSERT INTO lfg_temp_voice_channels (channel_id, guild_id, owner_id) VALUES (?, ?, ?)
+		 ON CONFLICT(channel_id) DO NOTHING`,
+		channelID, guildID, ownerID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add lfg temp voice channel %s: %w", channelID, err)
+	}
+	return nil
+}
+
+// ListLFGTempVoiceChannels returns all tracked temporary voice channels, oldest first.
+func (db *DB) ListLFGTempVoiceChannels() ([]LFGTempVoiceChannel, error) {
+	rows, err := db.conn.Query(
+		`SELECT channel_id, guild_id, owner_id, created_at FROM lfg_temp_voice_channels ORDER BY created_at, rowid`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lfg temp voice channels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []LFGTempVoiceChannel
+	for rows.Next() {
+		var c LFGTempVoiceChannel
+		if err := rows.Scan(&c.ChannelID, &c.GuildID, &c.OwnerID, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan lfg temp voice channel: %w", err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lfg temp voice channels: %w", err)
+	}
+	return out, nil
+}
+
+// RemoveLFGTempVoiceChannel stops tracking a temporary voice channel. Removing
+// a missing row is a no-op.
+func (db *DB) RemoveLFGTempVoiceChannel(channelID string) error {
+	_, err := db.conn.Exec(`DELETE FROM lfg_temp_voice_channels WHERE channel_id = ?`, channelID)
+	if err != nil {
+		return fmt.Errorf("failed to remove lfg temp voice channel %s: %w", channelID, err)
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Schema changes are applied as ordered, numbered migrations. Each one runs
+// in its own transaction and is recorded in schema_migrations, so it is
+// applied exactly once per database. To change the schema, append a new
+// migration with the next version; never edit one that has already shipped.
+//
+// Migration 1 is the schema as it existed before versioning. It uses
+// IF NOT EXISTS throughout so it is safe on databases created by older builds.
+
+// migration is a single versioned schema change.
+type migration struct {
+	version int
+	name    string
+	up      func(tx *sql.Tx) error
+}
+
+var migrations = []migration{
+	{version: 1, name: "initial schema", up: migrateInitialSchema},
+	{version: 2, name: "scheduled_says allow_pings and split", up: migrateScheduledSaysFlags},
+	{version: 3, name: "prune_allowlist", up: migratePruneAllowlist},
+	{version: 4, name: "user_timezones", up: migrateUserTimezones},
+	{version: 5, name: "job_runs", up: migrateJobRuns},
+}
+
+// migrate applies every migration not yet recorded in schema_migrations.
+func (db *DB) migrate() error {
+	if _, err := db.conn.Exec(`
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	applied, err := db.appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		if err := db.applyMigration(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appliedMigrations returns the set of recorded migration versions.
+func (db *DB) appliedMigrations() (map[int]bool, error) {
+	rows, err := db.conn.Query(`SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("failed to scan migration version: %w", err)
+		}
+		applied[v] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate applied migrations: %w", err)
+	}
+	return applied, nil
+}
+
+// applyMigration runs m and records it in one transaction.
+func (db *DB) applyMigration(m migration) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %d: %w", m.version, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := m.up(tx); err != nil {
+		return fmt.Errorf("migration %d (%s) failed: %w", m.version, m.name, err)
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.version, m.name); err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", m.version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %d: %w", m.version, err)
+	}
+	return nil
+}
+
+// hasColumn reports whether table has a column with the given name.
+func hasColumn(tx *sql.Tx, table, column string) (bool, error) {
+	var n int
+	err := tx.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, table, column).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s schema: %w", table, err)
+	}
+	return n > 0, nil
+}
+
+func migrateInitialSchema(tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS welcome_messages (
+	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+	    user_id TEXT NOT NULL,
+	    message TEXT NOT NULL,
+	    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_welcome_messages_user_id ON welcome_messages(user_id);
+
+	CREATE TABLE IF NOT EXISTS intro_feed_posts (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		user_id TEXT NOT NULL,
+		thread_id TEXT NOT NULL,
+		feed_message_id TEXT,
+		is_bump BOOLEAN NOT NULL DEFAULT 0,
+		posted_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_user_id ON intro_feed_posts(user_id);
+	CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_posted_at ON intro_feed_posts(posted_at);
+
+	CREATE TABLE IF NOT EXISTS introduction_threads (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		thread_id TEXT NOT NULL UNIQUE,
+		user_id TEXT NOT NULL,
+		username TEXT,
+		thread_title TEXT,
+		first_message_content TEXT,
+		applied_tags TEXT DEFAULT '[]',
+		created_at DATETIME,
+		fetched_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_introduction_threads_user_id ON introduction_threads(user_id);
+	CREATE INDEX IF NOT EXISTS idx_introduction_threads_fetched_at ON introduction_threads(fetched_at);
+
+	CREATE TABLE IF NOT EXISTS scam_image_hashes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		hash TEXT NOT NULL UNIQUE,
+		added_by TEXT,
+		source TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_scam_image_hashes_hash ON scam_image_hashes(hash);
+
+	CREATE TABLE IF NOT EXISTS guild_config (
+		guild_id   TEXT NOT NULL,
+		key        TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		updated_by TEXT,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (guild_id, key)
+	);
+
+	CREATE TABLE IF NOT EXISTS lfg_temp_voice_channels (
+		channel_id TEXT PRIMARY KEY,
+		guild_id   TEXT NOT NULL,
+		owner_id   TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS scheduled_says (
+		id                   INTEGER PRIMARY KEY AUTOINCREMENT,
+		channel_id           TEXT NOT NULL,
+		content              TEXT NOT NULL,
+		fire_at              DATETIME NOT NULL,
+		scheduled_by         TEXT NOT NULL,
+		suppress_mod_message BOOLEAN NOT NULL DEFAULT 0
+	);
+
+	CREATE TABLE IF NOT EXISTS command_usage (
+		id           INTEGER PRIMARY KEY AUTOINCREMENT,
+		command_name TEXT NOT NULL,
+		guild_id     TEXT NOT NULL DEFAULT '',
+		user_id      TEXT NOT NULL DEFAULT '',
+		success      BOOLEAN NOT NULL,
+		used_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE INDEX IF NOT EXISTS idx_command_usage_used_at ON command_usage(used_at);
+	`); err != nil {
+		return err
+	}
+
+	// Databases from before intro feed bumps have an intro_feed_posts table
+	// without is_bump (and with a UNIQUE(thread_id) constraint); recreate it.
+	hasIsBump, err := hasColumn(tx, "intro_feed_posts", "is_bump")
+	if err != nil {
+		return err
+	}
+	if hasIsBump {
+		return nil
+	}
+	if _, err := tx.Exec(`DROP TABLE intro_feed_posts`); err != nil {
+		return fmt.Errorf("failed to drop old intro_feed_posts table: %w", err)
+	}
+	if _, err := tx.Exec(`
+		CREATE TABLE intro_feed_posts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id TEXT NOT NULL,
+			thread_id TEXT NOT NULL,
+			feed_message_id TEXT,
+			is_bump BOOLEAN NOT NULL DEFAULT 0,
+			posted_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_user_id ON intro_feed_posts(user_id);
+		CREATE INDEX IF NOT EXISTS idx_intro_feed_posts_posted_at ON intro_feed_posts(posted_at);
+	`); err != nil {
+		return fmt.Errorf("failed to recreate intro_feed_posts table: %w", err)
+	}
+	return nil
+}
+
+// migrateScheduledSaysFlags adds the /say allow_pings and split options. Builds
+// before versioning may already have added them, so each column is checked.
+func migrateScheduledSaysFlags(tx *sql.Tx) error {
+	for _, column := range []string{"allow_pings", "split"} {
+		has, err := hasColumn(tx, "scheduled_says", column)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		if _, err := tx.Exec(fmt.Sprintf(`ALTER TABLE scheduled_says ADD COLUMN %s BOOLEAN NOT NULL DEFAULT 0`, column)); err != nil {
+			return fmt.Errorf("failed to add %s to scheduled_says: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// migratePruneAllowlist adds the table of threads /prune-forum must never flag.
+func migratePruneAllowlist(tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS prune_allowlist (
+		guild_id   TEXT NOT NULL,
+		thread_id  TEXT NOT NULL,
+		added_by   TEXT NOT NULL,
+		note       TEXT NOT NULL DEFAULT '',
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (guild_id, thread_id)
+	)`); err != nil {
+		return fmt.Errorf("failed to create prune_allowlist table: %w", err)
+	}
+	return nil
+}
+
+// migrateUserTimezones adds the table of per-user timezone preferences.
+func migrateUserTimezones(tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS user_timezones (
+		user_id    TEXT PRIMARY KEY,
+		timezone   TEXT NOT NULL,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create user_timezones table: %w", err)
+	}
+	return nil
+}
+
+// migrateJobRuns adds the table of when periodic jobs last ran.
+func migrateJobRuns(tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+	CREATE TABLE IF NOT EXISTS job_runs (
+		job      TEXT NOT NULL,
+		guild_id TEXT NOT NULL,
+		last_run DATETIME NOT NULL,
+		PRIMARY KEY (job, guild_id)
+	)`); err != nil {
+		return fmt.Errorf("failed to create job_runs table: %w", err)
+	}
+	return nil
+}
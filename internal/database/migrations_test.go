@@ -0,0 +1,90 @@
+package database
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func appliedVersions(t *testing.T, db *DB) []int {
+	t.Helper()
+	rows, err := db.conn.Query(`SELECT version FROM schema_migrations ORDER BY version`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var out []int
+	for rows.Next() {
+		var v int
+		require.NoError(t, rows.Scan(&v))
+		out = append(out, v)
+	}
+	require.NoError(t, rows.Err())
+	return out
+}
+
+func allVersions() []int {
+	out := make([]int, 0, len(migrations))
+	for _, m := range migrations {
+		out = append(out, m.version)
+	}
+	return out
+}
+
+func TestMigrationsAreOrdered(t *testing.T) {
+	for idx, m := range migrations {
+		require.Equal(t, idx+1, m.version, "migration %q must use the next version number", m.name)
+	}
+}
+
+func TestMigrate_FreshAndAlreadyMigrated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := NewDB(path)
+	require.NoError(t, err)
+	require.Equal(t, allVersions(), appliedVersions(t, db))
+	_, err = db.InsertScheduledSay(ScheduledSay{ChannelID: "c1", Content: "hi", ScheduledBy: "m", AllowPings: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Reopening applies nothing new and keeps existing data.
+	db, err = NewDB(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.Equal(t, allVersions(), appliedVersions(t, db))
+	says, err := db.ListScheduledSays()
+	require.NoError(t, err)
+	require.Len(t, says, 1)
+	require.True(t, says[0].AllowPings)
+}
+
+func TestMigrate_UnversionedDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	// Simulate a database from before migrations existed: tables created
+	// ad hoc, an old scheduled_says shape, and no schema_migrations table.
+	raw, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	_, err = raw.Exec(`
+		CREATE TABLE welcome_messages (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id TEXT NOT NULL, message TEXT NOT NULL, created_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+		INSERT INTO welcome_messages (user_id, message) VALUES ('u1', 'hello');
+		CREATE TABLE scheduled_says (id INTEGER PRIMARY KEY AUTOINCREMENT, channel_id TEXT NOT NULL, content TEXT NOT NULL, fire_at DATETIME NOT NULL, scheduled_by TEXT NOT NULL, suppress_mod_message BOOLEAN NOT NULL DEFAULT 0);
+	`)
+	require.NoError(t, err)
+	require.NoError(t, raw.Close())
+
+	db, err := NewDB(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.Equal(t, allVersions(), appliedVersions(t, db))
+
+	msg, err := db.GetWelcomeMessage()
+	require.NoError(t, err)
+	require.Equal(t, "hello", msg)
+
+	_, err = db.InsertScheduledSay(ScheduledSay{ChannelID: "c1", Content: "hi", ScheduledBy: "m", Split: true})
+	require.NoError(t, err)
+	says, err := db.ListScheduledSays()
+	require.NoError(t, err)
+	require.True(t, says[0].Split)
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// prune_allowlist holds forum threads moderators have marked as known false
+// positives. /prune-forum and the scheduled intro prune never flag them.
+
+// PruneAllowlistEntry is one allowlisted thread.
+type PruneAllowlistEntry struct {
+	GuildID   string    `json:"guild_id"`
+	ThreadID  string    `json:"thread_id"`
+	AddedBy   string    `json:"added_by"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AddPruneAllowlistThread allowlists a thread. Returns true if it was newly
+// added, false if it was already on the list.
+func (db *DB) AddPruneAllowlistThread(guildID, threadID, addedBy, note string) (bool, error) {
+	res, err := db.conn.Exec(
+		`INSERT INTO prune_allowlist (guild_id, thread_id, added_by, note) VALUES (?, ?, ?, ?)
+		 ON CONFLICT(guild_id, thread_id) DO NOTHING`,
+		guildID, threadID, addedBy, note,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to allowlist thread %s: %w", threadID, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// RemovePruneAllowlistThread removes a thread from the allowlist. Returns
+// false if it wasn't on the list.
+func (db *DB) RemovePruneAllowlistThread(guildID, threadID string) (bool, error) {
+	res, err := db.conn.Exec(`DELETE FROM prune_allowlist WHERE guild_id = ? AND thread_id = ?`, guildID, threadID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove allowlisted thread %s: %w", threadID, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// ListPruneAllowlist returns a guild's allowlisted threads, oldest first.
+func (db *DB) ListPruneAllowlist(guildID string) ([]PruneAllowlistEntry, error) {
+	rows, err := db.conn.Query(
+		`SELECT guild_id, thread_id, added_by, note, created_at FROM prune_allowlist
+		 WHERE guild_id = ? ORDER BY created_at, rowid`,
+		guildID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prune allowlist: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []PruneAllowlistEntry
+	for rows.Next() {
+		var e PruneAllowlistEntry
+		if err := rows.Scan(&e.GuildID, &e.ThreadID, &e.AddedBy, &e.Note, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan prune allowlist entry: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate prune allowlist: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// scheduled_says holds every pending /schedulesay message. The say service
+// keeps its queue in memory for the minute checks but writes each change
+// through to this table, so a crash loses nothing and the row ID is the
+// message ID users see in /listscheduledsays.
+
+// ScheduledSay is a pending scheduled message.
+type ScheduledSay struct {
+	ID                 int64     `json:"id"`
+	ChannelID          string    `json:"channel_id"`
+	Content            string    `json:"content"`
+	FireAt             time.Time `json:"fire_at"`
+	ScheduledBy        string    `json:"scheduled_by"`
+	SuppressModMessage bool      `json:"suppress_mod_message"`
+	AllowPings         bool      `json:"allow_pings"`
+	Split              bool      `json:"split"`
+}
+
+// InsertScheduledSay stores a new scheduled message and returns its ID.
+func (db *DB) InsertScheduledSay(say ScheduledSay) (int64, error) {
+	res, err := db.conn.Exec(
+		`INSERT INTO scheduled_says (channel_id, content, fire_at, scheduled_by, suppress_mod_message, allow_pings, split) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		say.ChannelID, say.Content, say.FireAt.UTC(), say.ScheduledBy, say.SuppressModMessage, say.AllowPings, say.Split,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save scheduled say for channel %s: %w", say.ChannelID, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read scheduled say ID: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateScheduledSay rewrites the channel, content and fire time of the
+// scheduled message with say.ID.
+func (db *DB) UpdateScheduledSay(say ScheduledSay) error {
+	if _, err := db.conn.Exec(
+		`UPDATE scheduled_says SET channel_id = ?, content = ?, fire_at = ? WHERE id = ?`,
+		say.ChannelID, say.Content, say.FireAt.UTC(), say.ID,
+	); err != nil {
+		return fmt.Errorf("failed to update scheduled say %d: %w", say.ID, err)
+	}
+	return nil
+}
+
+// DeleteScheduledSay removes a scheduled message once it has fired or been
+// cancelled.
+func (db *DB) DeleteScheduledSay(id int64) error {
+	if _, err := db.conn.Exec(`DELETE FROM scheduled_says WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete scheduled say %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListScheduledSays returns every pending scheduled message, soonest first.
+func (db *DB) ListScheduledSays() ([]ScheduledSay, error) {
+	rows, err := db.conn.Query(
+		`SELECT id, channel_id, content, fire_at, scheduled_by, suppress_mod_message, allow_pings, split FROM scheduled_says ORDER BY fire_at, id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled says: %w", err)
+	}
+	defer rows.Close()
+
+	var out []ScheduledSay
+	for rows.Next() {
+		var s ScheduledSay
+		if err := rows.Scan(&s.ID, &s.ChannelID, &s.Content, &s.FireAt, &s.ScheduledBy, &s.SuppressModMessage, &s.AllowPings, &s.Split); err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled say: %w", err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scheduled says: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// user_timezones holds each user's preferred IANA timezone, used to read
+// absolute times like "3pm" in the user's local time.
+
+// SetUserTimezone stores a user's timezone. The name must be a valid IANA
+// zone such as "Europe/Berlin".
+func (db *DB) SetUserTimezone(userID, tz string) error {
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", tz, err)
+	}
+	_, err := db.conn.Exec(
+		`INSERT INTO user_timezones (user_id, timezone, updated_at) VALUES (?, ?, CURRENT_TIMESTAMP)
+		 ON CONFLICT(user_id) DO UPDATE SET timezone = excluded.timezone, updated_at = excluded.updated_at`,
+		userID, tz,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set timezone for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// ClearUserTimezone removes a user's timezone. Returns false if none was set.
+func (db *DB) ClearUserTimezone(userID string) (bool, error) {
+	res, err := db.conn.Exec(`DELETE FROM user_timezones WHERE user_id = ?`, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to clear timezone for user %s: %w", userID, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// GetUserTimezone returns a user's preferred timezone, or UTC when none is
+// set. A stored name that no longer loads also falls back to UTC.
+func (db *DB) GetUserTimezone(userID string) (*time.Location, error) {
+	var tz string
+	err := db.conn.QueryRow(`SELECT timezone FROM user_timezones WHERE user_id = ?`, userID).Scan(&tz)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.UTC, nil
+	}
+	if err != nil {
+		return time.UTC, fmt.Errorf("failed to get timezone for user %s: %w", userID, err)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
+}
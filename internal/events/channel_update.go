@@ -193,7 +193,9 @@ func getAllChannelMessages(s *discordgo.Session, channelID string) ([]*discordgo
 	const limit = 100
 
 	for {
-		messages, err := s.ChannelMessages(channelID, limit, beforeID, "", "")
+		messages, err := utils.WithRateLimitRetry(func() ([]*discordgo.Message, error) {
+			return s.ChannelMessages(channelID, limit, beforeID, "", "")
+		})
 		if err != nil {
 			return nil, fmt.Errorf("error fetching messages: %w", err)
 		}
@@ -1,20 +1,23 @@
 package forumcache
 
 import (
+	"context"
 	"fmt"
 	"gamerpal/internal/config"
+	"gamerpal/internal/utils"
 	"maps"
 	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"time"
-	"unicode"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 // ThreadMeta holds minimal metadata we care about for cached forum threads.
+// Lookups return the cached pointers, so a ThreadMeta is never modified once
+// cached; events swap in an updated copy instead. Callers must not modify it.
 type ThreadMeta struct {
 	ID          string
 	ForumID     string
@@ -24,6 +27,36 @@ type ThreadMeta struct {
 	CreatedAt   time.Time
 	Archived    bool
 	LastMessage string // last message ID (optional quick activity indicator)
+	// LastActivity is when the latest known message was posted, kept current
+	// by OnMessageCreate. It falls back to CreatedAt for threads with no
+	// known messages.
+	LastActivity time.Time
+}
+
+// activityAt derives a thread's last activity from its last message ID,
+// falling back to its creation time.
+func activityAt(lastMessageID string, created time.Time) time.Time {
+	if lastMessageID != "" {
+		if ts, err := discordgo.SnowflakeTimestamp(lastMessageID); err == nil && ts.After(created) {
+			return ts
+		}
+	}
+	return created
+}
+
+// SortByActivity orders threads by LastActivity desc, then CreatedAt desc,
+// then ID desc.
+func SortByActivity(threads []*ThreadMeta) {
+	sort.Slice(threads, func(i, j int) bool {
+		a, b := threads[i], threads[j]
+		if !a.LastActivity.Equal(b.LastActivity) {
+			return a.LastActivity.After(b.LastActivity)
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	})
 }
 
 // ForumStats exposes lightweight observability data.
@@ -38,7 +71,15 @@ type ForumStats struct {
 	EventAdds      int
 	EventUpdates   int
 	EventDeletes   int
+	EventMessages  int
 	Anomalies      int
+	// TruncatedSyncs counts full syncs that stopped at the archived page cap.
+	TruncatedSyncs int
+	// LastSyncTruncated reports whether the most recent full sync hit the cap,
+	// meaning older archived threads may be missing from the cache.
+	LastSyncTruncated bool
+	// LastActivity is the most recent LastActivity across cached threads.
+	LastActivity time.Time
 }
 
 // forumIndex maintains thread + secondary owner index for a single forum.
@@ -53,15 +94,57 @@ type forumIndex struct {
 	eventAdds     int
 	eventUpdates  int
 	eventDeletes  int
+	eventMessages int
 	anomalies     int
+	truncSyncs    int
+	lastTruncated bool
+}
+
+// applyLive swaps in the indexes from a full listing and records the sync.
+// Caller must hold idx.mu for writing.
+func (idx *forumIndex) applyLive(live liveForum) {
+	idx.threads = live.threads
+	idx.ownerLatest = live.ownerLatest
+	idx.nameExact = live.nameExact
+	idx.lastFullSync = time.Now()
+	idx.lastTruncated = live.truncated
+	if live.truncated {
+		idx.truncSyncs++
+	}
+}
+
+// copyForWrite replaces the cached meta for threadID with a copy and returns
+// the copy for the caller to modify. ListThreads and the search APIs hand
+// out the cached pointers and readers use them without a lock, so a cached
+// ThreadMeta is never modified in place. norm is the normalized name the meta
+// is indexed under in nameExact. Caller must hold idx.mu for writing.
+func (idx *forumIndex) copyForWrite(threadID, norm string) (*ThreadMeta, bool) {
+	old, ok := idx.threads[threadID]
+	if !ok {
+		return nil, false
+	}
+	meta := new(*old)
+	idx.threads[threadID] = meta
+	if idx.ownerLatest[meta.OwnerID] == old {
+		idx.ownerLatest[meta.OwnerID] = meta
+	}
+	if idx.nameExact[norm] == old {
+		idx.nameExact[norm] = meta
+	}
+	return meta, true
 }
 
+// refreshProgressEvery is how many archived pages are fetched between
+// progress log lines during a full listing.
+const refreshProgressEvery = 10
+
 // Service manages multiple forum indexes and provides lookup APIs.
 type Service struct {
 	mu      sync.RWMutex
 	forums  map[string]*forumIndex // forumID -> index
 	session *discordgo.Session     // hydrated after bot connects
 	config  *config.Config
+	rules   NormalizeRules // fixed at construction; indexes are keyed by it
 }
 
 // threadLister abstracts active + archived thread listing for RefreshForum logic.
@@ -75,7 +158,9 @@ type threadLister interface {
 type sessionLister struct{ *discordgo.Session }
 
 func (sl sessionLister) ListActiveThreads(guildID string) ([]*discordgo.Channel, error) {
-	active, err := sl.GuildThreadsActive(guildID)
+	active, err := utils.WithRateLimitRetry(func() (*discordgo.ThreadsList, error) {
+		return sl.GuildThreadsActive(guildID)
+	})
 	if err != nil || active == nil {
 		return nil, err
 	}
@@ -85,7 +170,9 @@ func (sl sessionLister) ListActiveThreads(guildID string) ([]*discordgo.Channel,
 func (sl sessionLister) ListArchivedThreads(forumID string, before *time.Time) ([]*discordgo.Channel, bool, error) {
 	// Discord docs: List Public Archived Threads are ordered by archive_timestamp desc.
 	// Passing an explicit non-zero limit (max 100) reduces number of round trips versus implicit default (often 25).
-	archived, err := sl.ThreadsArchived(forumID, before, 100)
+	archived, err := utils.WithRateLimitRetry(func() (*discordgo.ThreadsList, error) {
+		return sl.ThreadsArchived(forumID, before, 100)
+	})
 	if err != nil || archived == nil {
 		return nil, false, err
 	}
@@ -97,6 +184,10 @@ func NewForumCacheService(config *config.Config) *Service {
 	return &Service{
 		forums: make(map[string]*forumIndex),
 		config: config,
+		rules: NormalizeRules{
+			PreserveDigitSeparators: config.GetForumCachePreserveDigitSeparators(),
+			KeepSymbols:             config.GetForumCacheKeepSymbols(),
+		},
 	}
 }
 
@@ -125,6 +216,28 @@ func (s *Service) RegisterForum(forumID string) {
 	}
 }
 
+// RegisteredForums returns the IDs of all registered forums.
+func (s *Service) RegisteredForums() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Sorted(maps.Keys(s.forums))
+}
+
+// RefreshAll rebuilds every registered forum, stopping between forums once ctx
+// is done. All forums are attempted; the first error is returned.
+func (s *Service) RefreshAll(ctx context.Context, guildID string) error {
+	var firstErr error
+	for _, forumID := range s.RegisteredForums() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.RefreshForum(guildID, forumID); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to refresh forum %s: %w", forumID, err)
+		}
+	}
+	return firstErr
+}
+
 // RefreshForum performs a full rebuild (active + archived) of a specific forum.
 func (s *Service) RefreshForum(guildID, forumID string) error {
 	if s.session == nil {
@@ -133,34 +246,97 @@ func (s *Service) RefreshForum(guildID, forumID string) error {
 	return s.refreshForumWithLister(guildID, forumID, sessionLister{s.session})
 }
 
+// ClearForum empties a registered forum's index so the next RefreshForum
+// rebuilds it from scratch. Event counters are kept; only cached threads and
+// sync state are dropped. It reports whether the forum was registered.
+func (s *Service) ClearForum(forumID string) bool {
+	s.mu.RLock()
+	idx, exists := s.forums[forumID]
+	s.mu.RUnlock()
+	if !exists {
+		return false
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.threads = make(map[string]*ThreadMeta)
+	idx.ownerLatest = make(map[string]*ThreadMeta)
+	idx.nameExact = make(map[string]*ThreadMeta)
+	idx.lastFullSync = time.Time{}
+	idx.lastTruncated = false
+	return true
+}
+
 // refreshForumWithLister contains the core logic, parameterized by a threadLister for test seams.
 func (s *Service) refreshForumWithLister(guildID, forumID string, l threadLister) error {
 	s.RegisterForum(forumID)
 	idx := s.forums[forumID]
 
-	tempThreads := make(map[string]*ThreadMeta)
-	tempOwnerLatest := make(map[string]*ThreadMeta)
-	tempNameExact := make(map[string]*ThreadMeta)
+	live, err := s.listForum(guildID, forumID, l)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	idx.applyLive(live)
+	idx.mu.Unlock()
+	return nil
+}
+
+// liveForum is the result of a full live listing of one forum.
+type liveForum struct {
+	threads     map[string]*ThreadMeta
+	ownerLatest map[string]*ThreadMeta
+	nameExact   map[string]*ThreadMeta
+	// complete is false when archived pagination stopped early (an error or
+	// the page cap), so threads missing from the listing may still exist.
+	complete bool
+	// truncated is true when the archived page cap was reached.
+	truncated bool
+}
+
+// listForum fetches active + archived threads for a forum and seeds fresh
+// index maps from them. Only a failed active listing is an error; archived
+// pages are best-effort and bounded by forum_cache_max_archived_pages.
+func (s *Service) listForum(guildID, forumID string, l threadLister) (liveForum, error) {
+	start := time.Now()
+	maxPages := s.config.GetForumCacheMaxArchivedPages()
+	live := liveForum{
+		threads:     make(map[string]*ThreadMeta),
+		ownerLatest: make(map[string]*ThreadMeta),
+		nameExact:   make(map[string]*ThreadMeta),
+		complete:    true,
+	}
 
 	activeThreads, err := l.ListActiveThreads(guildID)
 	if err != nil {
+		s.mu.RLock()
+		idx := s.forums[forumID]
+		s.mu.RUnlock()
 		idx.mu.Lock()
 		idx.fullSyncErrs++
 		idx.mu.Unlock()
-		return fmt.Errorf("listing active threads failed: %w", err)
+		return live, fmt.Errorf("listing active threads failed: %w", err)
 	}
 	for _, th := range activeThreads {
 		if th.ParentID != forumID {
 			continue
 		}
-		s.seedMeta(tempThreads, tempOwnerLatest, tempNameExact, guildID, forumID, th)
+		s.seedMeta(live.threads, live.ownerLatest, live.nameExact, guildID, forumID, th)
 	}
 
 	var before *time.Time
+	pages := 0
 	for page := 1; ; page++ {
+		if maxPages > 0 && page > maxPages {
+			s.config.Logger.Warnf("ForumCache RefreshForum %s: stopped at archived page cap (%d); cache may be incomplete", forumID, maxPages)
+			live.complete = false
+			live.truncated = true
+			break
+		}
 		archivedThreads, hasMore, err := l.ListArchivedThreads(forumID, before)
 		if err != nil {
 			s.config.Logger.Errorf("ForumCache RefreshForum: error listing archived threads (page %d): %v", page, err)
+			live.complete = false
 			break
 		}
 		if len(archivedThreads) == 0 {
@@ -169,7 +345,11 @@ func (s *Service) refreshForumWithLister(guildID, forumID string, l threadLister
 		}
 
 		for _, th := range archivedThreads { // seed each archived thread into temp maps
-			s.seedMeta(tempThreads, tempOwnerLatest, tempNameExact, guildID, forumID, th)
+			s.seedMeta(live.threads, live.ownerLatest, live.nameExact, guildID, forumID, th)
+		}
+		pages = page
+		if page%refreshProgressEvery == 0 {
+			s.config.Logger.Infof("ForumCache RefreshForum %s: fetched %d archived pages (%d threads so far, %s elapsed)", forumID, page, len(live.threads), time.Since(start).Round(time.Millisecond))
 		}
 		if !hasMore { // no further pages advertised
 			s.config.Logger.Infof("ForumCache RefreshForum: no more archived pages (page %d, has_more=false)", page)
@@ -187,47 +367,19 @@ func (s *Service) refreshForumWithLister(guildID, forumID string, l threadLister
 			cursor = ts
 		} else {
 			s.config.Logger.Error("ForumCache RefreshForum: cannot derive pagination cursor; aborting further archived fetches")
+			live.complete = false
 			break
 		}
 		before = &cursor
 	}
-
-	now := time.Now()
-	idx.mu.Lock()
-	idx.threads = tempThreads
-	idx.ownerLatest = tempOwnerLatest
-	idx.nameExact = tempNameExact
-	idx.lastFullSync = now
-	idx.mu.Unlock()
-	return nil
+	s.config.Logger.Infof("ForumCache RefreshForum %s: listed %d threads (%d archived pages) in %s", forumID, len(live.threads), pages, time.Since(start).Round(time.Millisecond))
+	return live, nil
 }
 
-// normalizeName produces the canonical comparison form of a thread name.
-func normalizeName(s string) string {
-	s = strings.ToLower(strings.TrimSpace(s))
-	if s == "" {
-		return ""
-	}
-	var b strings.Builder
-	prevSpace := false
-	for _, r := range s {
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			b.WriteRune(r)
-			prevSpace = false
-			continue
-		}
-		if r == ' ' { // preserve single spaces
-			if !prevSpace {
-				b.WriteRune(' ')
-				prevSpace = true
-			}
-			continue
-		}
-		// Drop punctuation/symbols entirely (do not insert spaces) so internal punctuation like
-		// "R.E.P.O" normalizes to "repo" (query "repo" matches exact) and "No-Man's" -> "nomans".
-		// This favors contiguous matching; users typing without punctuation get expected hits.
-	}
-	return strings.TrimSpace(b.String())
+// normalizeName produces the canonical comparison form of a thread name
+// using the service's normalization rules.
+func (s *Service) normalizeName(name string) string {
+	return s.rules.Normalize(name)
 }
 
 // latestTieBreak returns true if a should replace b as latest given CreatedAt then ID lexicographic.
@@ -260,13 +412,14 @@ func (s *Service) seedMeta(tempThreads map[string]*ThreadMeta, tempOwnerLatest m
 		Archived:    th.ThreadMetadata != nil && th.ThreadMetadata.Archived,
 		LastMessage: th.LastMessageID,
 	}
+	meta.LastActivity = activityAt(meta.LastMessage, created)
 	tempThreads[th.ID] = meta
 	// Owner latest selection (CreatedAt then ID tie-break)
 	if prev := tempOwnerLatest[meta.OwnerID]; latestTieBreak(meta, prev) {
 		tempOwnerLatest[meta.OwnerID] = meta
 	}
 	// Exact name selection (duplicate names allowed; pick latest)
-	norm := normalizeName(meta.Name)
+	norm := s.normalizeName(meta.Name)
 	if prev := tempNameExact[norm]; latestTieBreak(meta, prev) {
 		tempNameExact[norm] = meta
 	}
@@ -313,23 +466,33 @@ func (s *Service) Stats(forumID string) (ForumStats, bool) {
 	}
 	idx.mu.RLock()
 	defer idx.mu.RUnlock()
+	var lastActivity time.Time
+	for _, t := range idx.threads {
+		if t.LastActivity.After(lastActivity) {
+			lastActivity = t.LastActivity
+		}
+	}
 	return ForumStats{
-		ForumID:        forumID,
-		Threads:        len(idx.threads),
-		OwnersTracked:  len(idx.ownerLatest),
-		LastFullSync:   idx.lastFullSync,
-		LastEventTime:  idx.lastEventTime,
-		FullSyncErrors: idx.fullSyncErrs,
-		EventAdds:      idx.eventAdds,
-		EventUpdates:   idx.eventUpdates,
-		EventDeletes:   idx.eventDeletes,
-		Anomalies:      idx.anomalies,
+		ForumID:           forumID,
+		Threads:           len(idx.threads),
+		OwnersTracked:     len(idx.ownerLatest),
+		LastFullSync:      idx.lastFullSync,
+		LastEventTime:     idx.lastEventTime,
+		FullSyncErrors:    idx.fullSyncErrs,
+		EventAdds:         idx.eventAdds,
+		EventUpdates:      idx.eventUpdates,
+		EventDeletes:      idx.eventDeletes,
+		EventMessages:     idx.eventMessages,
+		Anomalies:         idx.anomalies,
+		TruncatedSyncs:    idx.truncSyncs,
+		LastSyncTruncated: idx.lastTruncated,
+		LastActivity:      lastActivity,
 	}, true
 }
 
 // GetThreadByExactName returns the latest thread whose normalized name exactly matches.
 func (s *Service) GetThreadByExactName(forumID, name string) (*ThreadMeta, bool) {
-	norm := normalizeName(name)
+	norm := s.normalizeName(name)
 	s.mu.RLock()
 	idx, exists := s.forums[forumID]
 	s.mu.RUnlock()
@@ -342,10 +505,78 @@ func (s *Service) GetThreadByExactName(forumID, name string) (*ThreadMeta, bool)
 	return meta, ok
 }
 
+// matchBucket ranks how well a thread name matches a search query; lower is better.
+type matchBucket int
+
+const (
+	bucketExact matchBucket = iota
+	bucketPrefix
+	bucketBoundary
+	bucketContains
+	bucketNone
+)
+
+// classifyMatch buckets a normalized thread name against a normalized query.
+func classifyMatch(norm, q string) matchBucket {
+	switch {
+	case norm == q:
+		return bucketExact
+	case strings.HasPrefix(norm, q):
+		return bucketPrefix
+	case slices.Contains(strings.Fields(norm), q): // word boundary: any token equals query
+		return bucketBoundary
+	case strings.Contains(norm, q):
+		return bucketContains
+	default:
+		return bucketNone
+	}
+}
+
+// rankedMeta is a search hit tagged with its bucket for merging.
+type rankedMeta struct {
+	meta   *ThreadMeta
+	bucket matchBucket
+}
+
+// collectMatches appends every thread in idx matching q. Caller must not hold idx.mu.
+func (idx *forumIndex) collectMatches(q string, normalize func(string) string, out []rankedMeta) []rankedMeta {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	for _, meta := range idx.threads {
+		if b := classifyMatch(normalize(meta.Name), q); b != bucketNone {
+			out = append(out, rankedMeta{meta: meta, bucket: b})
+		}
+	}
+	return out
+}
+
+// rankMatches orders hits by bucket, then CreatedAt desc, then ID desc, and
+// returns up to limit of them.
+func rankMatches(hits []rankedMeta, limit int) []*ThreadMeta {
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if a.bucket != b.bucket {
+			return a.bucket < b.bucket
+		}
+		if a.meta.CreatedAt.Equal(b.meta.CreatedAt) {
+			return a.meta.ID > b.meta.ID
+		}
+		return a.meta.CreatedAt.After(b.meta.CreatedAt)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	out := make([]*ThreadMeta, len(hits))
+	for i, h := range hits {
+		out[i] = h.meta
+	}
+	return out
+}
+
 // SearchThreads performs a scored search (exact > prefix > word boundary > contains) over cached threads.
 // Returns up to limit results (if limit <=0 default to 25).
 func (s *Service) SearchThreads(forumID, query string, limit int) ([]*ThreadMeta, bool) {
-	q := normalizeName(query)
+	q := s.normalizeName(query)
 	if q == "" {
 		return nil, false
 	}
@@ -358,59 +589,45 @@ func (s *Service) SearchThreads(forumID, query string, limit int) ([]*ThreadMeta
 	if !exists {
 		return nil, false
 	}
-	idx.mu.RLock()
-	defer idx.mu.RUnlock()
-	// Buckets
-	exact := make([]*ThreadMeta, 0)
-	prefix := make([]*ThreadMeta, 0)
-	boundary := make([]*ThreadMeta, 0)
-	contains := make([]*ThreadMeta, 0)
+	return rankMatches(idx.collectMatches(q, s.normalizeName, nil), limit), true
+}
 
-	for _, meta := range idx.threads {
-		norm := normalizeName(meta.Name)
-		if norm == q {
-			exact = append(exact, meta)
-			continue
-		}
-		if strings.HasPrefix(norm, q) {
-			prefix = append(prefix, meta)
+// SearchThreadsAcross runs SearchThreads over several forums at once and
+// merges the results, keeping bucket priority across forums (an exact hit in
+// any forum outranks a prefix hit in another). Each result's ForumID tags its
+// source forum. Unregistered forums are skipped; ok is false when none of
+// them are registered.
+func (s *Service) SearchThreadsAcross(forumIDs []string, query string, limit int) ([]*ThreadMeta, bool) {
+	q := s.normalizeName(query)
+	if q == "" {
+		return nil, false
+	}
+	if limit <= 0 {
+		limit = 25
+	}
+	var (
+		hits  []rankedMeta
+		found bool
+	)
+	seen := make(map[string]struct{}, len(forumIDs))
+	for _, forumID := range forumIDs {
+		if _, dup := seen[forumID]; dup {
 			continue
 		}
-		// word boundary: any token equals query
-		tokens := strings.Fields(norm)
-		matchedBoundary := slices.Contains(tokens, q)
-		if matchedBoundary {
-			boundary = append(boundary, meta)
+		seen[forumID] = struct{}{}
+		s.mu.RLock()
+		idx, exists := s.forums[forumID]
+		s.mu.RUnlock()
+		if !exists {
 			continue
 		}
-		if strings.Contains(norm, q) {
-			contains = append(contains, meta)
-		}
-	}
-
-	// Sort each bucket by CreatedAt desc then ID desc.
-	sorter := func(sl []*ThreadMeta) {
-		sort.Slice(sl, func(i, j int) bool {
-			if sl[i].CreatedAt.Equal(sl[j].CreatedAt) {
-				return sl[i].ID > sl[j].ID
-			}
-			return sl[i].CreatedAt.After(sl[j].CreatedAt)
-		})
+		found = true
+		hits = idx.collectMatches(q, s.normalizeName, hits)
 	}
-	sorter(exact)
-	sorter(prefix)
-	sorter(boundary)
-	sorter(contains)
-
-	merged := make([]*ThreadMeta, 0, len(exact)+len(prefix)+len(boundary)+len(contains))
-	merged = append(merged, exact...)
-	merged = append(merged, prefix...)
-	merged = append(merged, boundary...)
-	merged = append(merged, contains...)
-	if len(merged) > limit {
-		merged = merged[:limit]
+	if !found {
+		return nil, false
 	}
-	return merged, true
+	return rankMatches(hits, limit), true
 }
 
 // --- Event Handlers (called from bot) ---
@@ -439,12 +656,13 @@ func (s *Service) OnThreadCreate(_ *discordgo.Session, e *discordgo.ThreadCreate
 		Archived:    thread.ThreadMetadata != nil && thread.ThreadMetadata.Archived,
 		LastMessage: thread.LastMessageID,
 	}
+	meta.LastActivity = activityAt(meta.LastMessage, created)
 	idx.mu.Lock()
 	idx.threads[meta.ID] = meta
 	if prev := idx.ownerLatest[meta.OwnerID]; latestTieBreak(meta, prev) {
 		idx.ownerLatest[meta.OwnerID] = meta
 	}
-	norm := normalizeName(meta.Name)
+	norm := s.normalizeName(meta.Name)
 	if prev := idx.nameExact[norm]; latestTieBreak(meta, prev) {
 		idx.nameExact[norm] = meta
 	}
@@ -467,12 +685,20 @@ func (s *Service) OnThreadUpdate(_ *discordgo.Session, e *discordgo.ThreadUpdate
 		return
 	}
 	idx.mu.Lock()
-	if meta, ok := idx.threads[thread.ID]; ok {
-		oldNorm := normalizeName(meta.Name)
+	var meta *ThreadMeta
+	var ok bool
+	if cur := idx.threads[thread.ID]; cur != nil {
+		meta, ok = idx.copyForWrite(thread.ID, s.normalizeName(cur.Name))
+	}
+	if ok {
+		oldNorm := s.normalizeName(meta.Name)
 		meta.Name = thread.Name
 		meta.Archived = thread.ThreadMetadata != nil && thread.ThreadMetadata.Archived
 		meta.LastMessage = thread.LastMessageID
-		newNorm := normalizeName(meta.Name)
+		if at := activityAt(meta.LastMessage, meta.CreatedAt); at.After(meta.LastActivity) {
+			meta.LastActivity = at
+		}
+		newNorm := s.normalizeName(meta.Name)
 		if oldNorm != newNorm {
 			// If this meta was the representative of oldNorm, find replacement.
 			if cur := idx.nameExact[oldNorm]; cur == meta {
@@ -481,7 +707,7 @@ func (s *Service) OnThreadUpdate(_ *discordgo.Session, e *discordgo.ThreadUpdate
 					if t == meta {
 						continue
 					}
-					if normalizeName(t.Name) != oldNorm {
+					if s.normalizeName(t.Name) != oldNorm {
 						continue
 					}
 					if latestTieBreak(t, replacement) {
@@ -546,11 +772,11 @@ func (s *Service) OnThreadDelete(_ *discordgo.Session, e *discordgo.ThreadDelete
 			}
 		}
 		// Name exact fallback.
-		norm := normalizeName(meta.Name)
+		norm := s.normalizeName(meta.Name)
 		if cur := idx.nameExact[norm]; cur == meta {
 			var replacement *ThreadMeta
 			for _, t := range idx.threads {
-				if normalizeName(t.Name) != norm {
+				if s.normalizeName(t.Name) != norm {
 					continue
 				}
 				if latestTieBreak(t, replacement) {
@@ -571,6 +797,40 @@ func (s *Service) OnThreadDelete(_ *discordgo.Session, e *discordgo.ThreadDelete
 	idx.mu.Unlock()
 }
 
+// OnMessageCreate bumps LastActivity on the cached thread the message was
+// posted in. Messages outside cached threads cost one read-locked map lookup
+// per registered forum.
+func (s *Service) OnMessageCreate(_ *discordgo.Session, e *discordgo.MessageCreate) {
+	if e == nil || e.Message == nil {
+		return
+	}
+	at := e.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, idx := range s.forums {
+		idx.mu.RLock()
+		_, cached := idx.threads[e.ChannelID]
+		idx.mu.RUnlock()
+		if !cached {
+			continue
+		}
+		idx.mu.Lock()
+		if cur := idx.threads[e.ChannelID]; cur != nil {
+			meta, _ := idx.copyForWrite(e.ChannelID, s.normalizeName(cur.Name))
+			meta.LastMessage = e.ID
+			if at.After(meta.LastActivity) {
+				meta.LastActivity = at
+			}
+			idx.eventMessages++
+		}
+		idx.mu.Unlock()
+		return
+	}
+}
+
 // OnThreadListSync can refresh known subset – here we just mark anomalies if forum not registered;
 // otherwise treat as soft rebuild for listed threads only.
 func (s *Service) OnThreadListSync(_ *discordgo.Session, e *discordgo.ThreadListSync) {
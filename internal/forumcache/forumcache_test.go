@@ -201,6 +201,36 @@ func TestRefreshForum_SinglePage(t *testing.T) {
 	assert.True(t, !stats.LastFullSync.IsZero())
 }
 
+func TestClearForum(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	require.False(t, svc.ClearForum("missing"))
+
+	forumID := "f-clear"
+	l := &mockLister{
+		active:          []*discordgo.Channel{mockThread("10", forumID, "u1", "Halo", false)},
+		archivedBatches: [][]*discordgo.Channel{{mockThread("20", forumID, "u2", "Doom", true)}},
+		archivedHasMore: []bool{false},
+		archivedErrs:    []error{nil},
+	}
+	require.NoError(t, svc.refreshForumWithLister("g1", forumID, l))
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThread("30", forumID, "u3", "Tetris", false)})
+
+	require.True(t, svc.ClearForum(forumID))
+	stats, ok := svc.Stats(forumID)
+	require.True(t, ok, "forum stays registered")
+	assert.Zero(t, stats.Threads)
+	assert.Zero(t, stats.OwnersTracked)
+	assert.True(t, stats.LastFullSync.IsZero())
+	assert.Equal(t, 1, stats.EventAdds, "event counters survive a clear")
+	_, found := svc.GetThreadByExactName(forumID, "Halo")
+	assert.False(t, found)
+
+	l.archivedCall = 0
+	require.NoError(t, svc.refreshForumWithLister("g1", forumID, l))
+	stats, _ = svc.Stats(forumID)
+	assert.Equal(t, 2, stats.Threads)
+}
+
 func TestRefreshForum_ActiveError(t *testing.T) {
 	_, svc := NewTestForumCache(nil)
 	guildID := "g2"
@@ -381,3 +411,172 @@ func TestPunctuationInsensitiveNormalization(t *testing.T) {
 		assert.True(t, found, "expected search to locate 'No-Man's Sky' with query 'nomans'")
 	}
 }
+
+func TestRefreshForum_ArchivedPageCap(t *testing.T) {
+	_, svc := NewTestForumCache(map[string]any{"forum_cache_max_archived_pages": 2})
+	forumID := "f-cap"
+	l := &mockLister{
+		active: []*discordgo.Channel{mockThread("1", forumID, "uA", "t1", false)},
+		archivedBatches: [][]*discordgo.Channel{
+			{mockThread("2", forumID, "uB", "t2", true)},
+			{mockThread("3", forumID, "uC", "t3", true)},
+			{mockThread("4", forumID, "uD", "t4", true)}, // beyond the cap
+		},
+		archivedHasMore: []bool{true, true, false},
+		archivedErrs:    []error{nil, nil, nil},
+	}
+	require.NoError(t, svc.refreshForumWithLister("g", forumID, l))
+	assert.Equal(t, 2, l.archivedCall, "no page past the cap should be requested")
+
+	stats, _ := svc.Stats(forumID)
+	assert.Equal(t, 3, stats.Threads)
+	assert.True(t, stats.LastSyncTruncated)
+	assert.Equal(t, 1, stats.TruncatedSyncs)
+
+	// A later sync that finishes within the cap clears the flag.
+	require.NoError(t, svc.refreshForumWithLister("g", forumID, &mockLister{
+		archivedBatches: [][]*discordgo.Channel{{mockThread("2", forumID, "uB", "t2", true)}},
+		archivedHasMore: []bool{false},
+		archivedErrs:    []error{nil},
+	}))
+	stats, _ = svc.Stats(forumID)
+	assert.False(t, stats.LastSyncTruncated)
+	assert.Equal(t, 1, stats.TruncatedSyncs)
+}
+
+func TestSearchThreadsAcross(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	lfg, intro := "f-lfg", "f-intro"
+	svc.RegisterForum(lfg)
+	svc.RegisterForum(intro)
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThreadSimple("10", lfg, "u1", "Elden Ring")})
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThreadSimple("11", lfg, "u2", "Lore of Elden")})
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThreadSimple("20", intro, "u3", "Elden")})
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThreadSimple("21", intro, "u4", "Eldenish")})
+
+	results, ok := svc.SearchThreadsAcross([]string{lfg, intro, "unregistered", lfg}, "elden", 10)
+	require.True(t, ok)
+	require.Len(t, results, 4)
+	// Exact (intro) > prefix (lfg "Elden Ring" and intro "Eldenish", newest first) > boundary (lfg)
+	assert.Equal(t, "20", results[0].ID)
+	assert.Equal(t, intro, results[0].ForumID)
+	assert.Equal(t, "21", results[1].ID)
+	assert.Equal(t, "10", results[2].ID)
+	assert.Equal(t, lfg, results[2].ForumID)
+	assert.Equal(t, "11", results[3].ID)
+
+	limited, ok := svc.SearchThreadsAcross([]string{lfg, intro}, "elden", 2)
+	require.True(t, ok)
+	require.Len(t, limited, 2)
+	assert.Equal(t, "20", limited[0].ID)
+
+	_, ok = svc.SearchThreadsAcross([]string{"nope"}, "elden", 10)
+	assert.False(t, ok)
+	_, ok = svc.SearchThreadsAcross([]string{lfg}, "   ", 10)
+	assert.False(t, ok)
+}
+
+func TestOnMessageCreateTracksActivity(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-activity"
+	svc.RegisterForum(forumID)
+	svc.RegisterForum("f-other")
+
+	// IDs double as snowflakes, so creation time derives from them.
+	older := mockThread("1000000000000000000", forumID, "u1", "Halo", false)
+	newer := mockThread("1100000000000000000", forumID, "u2", "Doom", false)
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: older})
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: newer})
+
+	threads, _ := svc.ListThreads(forumID)
+	for _, th := range threads {
+		assert.Equal(t, th.CreatedAt, th.LastActivity, "no messages yet: activity is creation time")
+	}
+	SortByActivity(threads)
+	require.Equal(t, newer.ID, threads[0].ID)
+
+	posted := time.Now().UTC().Truncate(time.Second)
+	svc.OnMessageCreate(nil, &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1", ChannelID: older.ID, Timestamp: posted}})
+	// Messages in uncached channels are ignored.
+	svc.OnMessageCreate(nil, &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m2", ChannelID: "elsewhere", Timestamp: posted}})
+
+	threads, _ = svc.ListThreads(forumID)
+	SortByActivity(threads)
+	require.Equal(t, older.ID, threads[0].ID)
+	assert.Equal(t, posted, threads[0].LastActivity)
+	assert.Equal(t, "m1", threads[0].LastMessage)
+
+	stats, _ := svc.Stats(forumID)
+	assert.Equal(t, 1, stats.EventMessages)
+	assert.Equal(t, posted, stats.LastActivity)
+	other, _ := svc.Stats("f-other")
+	assert.Zero(t, other.EventMessages)
+
+	// A late-delivered older message never moves activity backwards.
+	svc.OnMessageCreate(nil, &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m0", ChannelID: older.ID, Timestamp: posted.Add(-time.Hour)}})
+	stats, _ = svc.Stats(forumID)
+	assert.Equal(t, posted, stats.LastActivity)
+}
+
+// TestEventsDoNotMutateHandedOutMeta verifies message and update events swap
+// in a copy instead of writing to ThreadMeta pointers readers may hold.
+func TestEventsDoNotMutateHandedOutMeta(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-cow"
+	svc.RegisterForum(forumID)
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThread("1000000000000000000", forumID, "u1", "Halo", false)})
+
+	held, ok := svc.GetThreadByExactName(forumID, "halo")
+	require.True(t, ok)
+	before := *held
+
+	posted := time.Now().UTC().Truncate(time.Second)
+	svc.OnMessageCreate(nil, &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1", ChannelID: held.ID, Timestamp: posted}})
+	svc.OnThreadUpdate(nil, &discordgo.ThreadUpdate{Channel: mockThread(held.ID, forumID, "u1", "Halo 2", true)})
+	assert.Equal(t, before, *held, "a pointer already handed out must not change")
+
+	// Every index sees the updated copy.
+	byName, ok := svc.GetThreadByExactName(forumID, "halo 2")
+	require.True(t, ok)
+	assert.True(t, byName.Archived)
+	_, ok = svc.GetThreadByExactName(forumID, "halo")
+	assert.False(t, ok)
+	byOwner, ok := svc.GetLatestUserThread(forumID, "u1")
+	require.True(t, ok)
+	assert.Same(t, byName, byOwner)
+	threads, _ := svc.ListThreads(forumID)
+	require.Len(t, threads, 1)
+	assert.Same(t, byName, threads[0])
+}
+
+// TestMessageEventsRaceReaders exercises concurrent readers of handed-out
+// metas against message events; run with -race.
+func TestMessageEventsRaceReaders(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-race"
+	svc.RegisterForum(forumID)
+	thread := mockThread("1000000000000000000", forumID, "u1", "Halo", false)
+	svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: thread})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for n := range 200 {
+			svc.OnMessageCreate(nil, &discordgo.MessageCreate{Message: &discordgo.Message{ID: fmt.Sprint(n), ChannelID: thread.ID, Timestamp: time.Now()}})
+		}
+	}()
+	for range 200 {
+		threads, _ := svc.ListThreads(forumID)
+		SortByActivity(threads)
+		_ = threads[0].LastMessage
+	}
+	<-done
+}
+
+func TestActivityAtFromLastMessage(t *testing.T) {
+	created, _ := discordgo.SnowflakeTimestamp("1000000000000000000")
+	last, _ := discordgo.SnowflakeTimestamp("1100000000000000000")
+	assert.Equal(t, last, activityAt("1100000000000000000", created))
+	assert.Equal(t, created, activityAt("", created))
+	assert.Equal(t, created, activityAt("not-a-snowflake", created))
+}
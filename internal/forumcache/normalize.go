@@ -0,0 +1,61 @@
+package forumcache
+
+import (
+	"strings"
+	"unicode"
+)
+
+// digitSeparators are the runes PreserveDigitSeparators keeps between digits.
+const digitSeparators = "-.:/"
+
+// NormalizeRules controls how thread names are folded before exact-name
+// lookups and search. The zero value is the default behavior: lowercase,
+// collapse spaces, and drop all punctuation and symbols.
+type NormalizeRules struct {
+	// PreserveDigitSeparators keeps '-', '.', ':' and '/' when they sit
+	// between two digits, so "1-2-Switch" stays distinct from "12 Switch".
+	PreserveDigitSeparators bool
+	// KeepSymbols lists extra runes kept verbatim, e.g. "+#" keeps "C++"
+	// from merging with "C".
+	KeepSymbols string
+}
+
+// Normalize produces the canonical comparison form of a thread name.
+func (r NormalizeRules) Normalize(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ""
+	}
+	runes := []rune(s)
+	var b strings.Builder
+	prevSpace := false
+	for i, ch := range runes {
+		if unicode.IsLetter(ch) || unicode.IsDigit(ch) {
+			b.WriteRune(ch)
+			prevSpace = false
+			continue
+		}
+		if ch == ' ' { // preserve single spaces
+			if !prevSpace {
+				b.WriteRune(' ')
+				prevSpace = true
+			}
+			continue
+		}
+		if r.KeepSymbols != "" && strings.ContainsRune(r.KeepSymbols, ch) {
+			b.WriteRune(ch)
+			prevSpace = false
+			continue
+		}
+		if r.PreserveDigitSeparators && strings.ContainsRune(digitSeparators, ch) &&
+			i > 0 && i < len(runes)-1 && unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1]) {
+			b.WriteRune(ch)
+			prevSpace = false
+			continue
+		}
+		// Drop punctuation/symbols entirely (do not insert spaces) so internal punctuation like
+		// "R.E.P.O" normalizes to "repo" (query "repo" matches exact) and "No-Man's" -> "nomans".
+		// This favors contiguous matching; users typing without punctuation get expected hits.
+	}
+	return strings.TrimSpace(b.String())
+}
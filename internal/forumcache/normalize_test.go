@@ -0,0 +1,51 @@
+package forumcache
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRules(t *testing.T) {
+	alt := NormalizeRules{PreserveDigitSeparators: true, KeepSymbols: "+#"}
+	cases := []struct {
+		in, def, alt string
+	}{
+		{"Tom Clancy's Rainbow Six", "tom clancys rainbow six", "tom clancys rainbow six"},
+		{"  R.E.P.O  ", "repo", "repo"},
+		{"F.T.L", "ftl", "ftl"},
+		{"1-2-Switch", "12switch", "1-2switch"},
+		{"Half-Life 2: Episode 1", "halflife 2 episode 1", "halflife 2 episode 1"},
+		{"Version 1.5", "version 15", "version 1.5"},
+		{"C++ Quest", "c quest", "c++ quest"},
+		{"C# Tycoon", "c tycoon", "c# tycoon"},
+		{"", "", ""},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.def, NormalizeRules{}.Normalize(c.in), "default %q", c.in)
+		assert.Equal(t, c.alt, alt.Normalize(c.in), "alternate %q", c.in)
+	}
+}
+
+func TestServiceUsesConfiguredRules(t *testing.T) {
+	_, def := NewTestForumCache(nil)
+	_, alt := NewTestForumCache(map[string]any{
+		"forum_cache_preserve_digit_separators": true,
+		"forum_cache_keep_symbols":              "+",
+	})
+	for _, svc := range []*Service{def, alt} {
+		svc.RegisterForum("f")
+		svc.OnThreadCreate(nil, &discordgo.ThreadCreate{Channel: mockThreadSimple("1", "f", "u1", "C++ Quest")})
+	}
+
+	// Default rules merge "C Quest" into "C++ Quest"; the alternate set keeps them apart.
+	_, ok := def.GetThreadByExactName("f", "C Quest")
+	assert.True(t, ok)
+	_, ok = alt.GetThreadByExactName("f", "C Quest")
+	assert.False(t, ok)
+	meta, ok := alt.GetThreadByExactName("f", "c++ quest")
+	require.True(t, ok)
+	assert.Equal(t, "1", meta.ID)
+}
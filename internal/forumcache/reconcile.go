@@ -0,0 +1,153 @@
+package forumcache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ReconcileResult summarizes the corrections made by a ReconcileForum pass.
+type ReconcileResult struct {
+	ForumID string
+	// Added are live threads the cache was missing.
+	Added []*ThreadMeta
+	// Removed are cached threads Discord no longer lists (phantoms).
+	Removed []*ThreadMeta
+	// Updated are cached threads whose name or archive state had drifted.
+	Updated []*ThreadMeta
+	// Partial means the archived listing stopped early, so removals were
+	// skipped rather than risk dropping threads that still exist.
+	Partial bool
+	// Initial means the forum had never been fully synced, so the pass was a
+	// cold build and nothing was counted as an anomaly.
+	Initial  bool
+	Duration time.Duration
+}
+
+// Corrections returns the total number of threads added, removed or updated.
+func (r ReconcileResult) Corrections() int {
+	return len(r.Added) + len(r.Removed) + len(r.Updated)
+}
+
+// String renders a one-line summary for logs and admin replies.
+func (r ReconcileResult) String() string {
+	s := fmt.Sprintf("added=%d removed=%d updated=%d in %s", len(r.Added), len(r.Removed), len(r.Updated), r.Duration.Round(time.Millisecond))
+	if r.Partial {
+		s += " (partial listing; removals skipped)"
+	}
+	if r.Initial {
+		s += " (initial build)"
+	}
+	return s
+}
+
+// ReconcileForum does a full live listing of a forum, diffs it against the
+// cache and corrects any drift left by missed gateway events. Each
+// correction bumps the matching event counter and the anomaly counter.
+func (s *Service) ReconcileForum(guildID, forumID string) (ReconcileResult, error) {
+	if s.session == nil {
+		return ReconcileResult{ForumID: forumID}, fmt.Errorf("forum cache not hydrated with session")
+	}
+	return s.reconcileForumWithLister(guildID, forumID, sessionLister{s.session})
+}
+
+// ReconcileAll reconciles every registered forum, stopping between forums
+// once ctx is done. All forums are attempted; the first error is returned.
+func (s *Service) ReconcileAll(ctx context.Context, guildID string) ([]ReconcileResult, error) {
+	var (
+		results  []ReconcileResult
+		firstErr error
+	)
+	for _, forumID := range s.RegisteredForums() {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		res, err := s.ReconcileForum(guildID, forumID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to reconcile forum %s: %w", forumID, err)
+			}
+			continue
+		}
+		results = append(results, res)
+	}
+	return results, firstErr
+}
+
+// reconcileForumWithLister contains the core logic, parameterized by a threadLister for test seams.
+func (s *Service) reconcileForumWithLister(guildID, forumID string, l threadLister) (ReconcileResult, error) {
+	start := time.Now()
+	res := ReconcileResult{ForumID: forumID}
+	s.RegisterForum(forumID)
+	s.mu.RLock()
+	idx := s.forums[forumID]
+	s.mu.RUnlock()
+
+	live, err := s.listForum(guildID, forumID, l)
+	if err != nil {
+		res.Duration = time.Since(start)
+		return res, err
+	}
+	res.Partial = !live.complete
+
+	idx.mu.Lock()
+	res.Initial = idx.lastFullSync.IsZero()
+	for id, meta := range live.threads {
+		cached, ok := idx.threads[id]
+		switch {
+		case !ok:
+			res.Added = append(res.Added, meta)
+		case cached.Name != meta.Name || cached.Archived != meta.Archived:
+			res.Updated = append(res.Updated, meta)
+		}
+	}
+	for id, cached := range idx.threads {
+		if _, ok := live.threads[id]; ok {
+			continue
+		}
+		if res.Partial {
+			// Keep it; the listing may simply not have reached it.
+			live.threads[id] = cached
+			if prev := live.ownerLatest[cached.OwnerID]; latestTieBreak(cached, prev) {
+				live.ownerLatest[cached.OwnerID] = cached
+			}
+			norm := s.normalizeName(cached.Name)
+			if prev := live.nameExact[norm]; latestTieBreak(cached, prev) {
+				live.nameExact[norm] = cached
+			}
+			continue
+		}
+		res.Removed = append(res.Removed, cached)
+	}
+
+	idx.applyLive(live)
+	if !res.Initial {
+		idx.eventAdds += len(res.Added)
+		idx.eventDeletes += len(res.Removed)
+		idx.eventUpdates += len(res.Updated)
+		idx.anomalies += res.Corrections()
+	}
+	idx.mu.Unlock()
+
+	for _, list := range [][]*ThreadMeta{res.Added, res.Removed, res.Updated} {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+	res.Duration = time.Since(start)
+
+	if !res.Initial && res.Corrections() > 0 {
+		s.config.Logger.Warnf("ForumCache ReconcileForum %s: corrected drift: %s", forumID, res)
+		for _, m := range res.Added {
+			s.config.Logger.Debugf("ForumCache ReconcileForum %s: added missing thread %s (%q)", forumID, m.ID, m.Name)
+		}
+		for _, m := range res.Removed {
+			s.config.Logger.Debugf("ForumCache ReconcileForum %s: removed phantom thread %s (%q)", forumID, m.ID, m.Name)
+		}
+		for _, m := range res.Updated {
+			s.config.Logger.Debugf("ForumCache ReconcileForum %s: updated stale thread %s (%q)", forumID, m.ID, m.Name)
+		}
+	} else {
+		s.config.Logger.Infof("ForumCache ReconcileForum %s: %s", forumID, res)
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package forumcache
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// singlePageLister returns a lister that reports the given active threads and
+// one archived page.
+func singlePageLister(active, archived []*discordgo.Channel) *mockLister {
+	return &mockLister{
+		active:          active,
+		archivedBatches: [][]*discordgo.Channel{archived},
+		archivedHasMore: []bool{false},
+		archivedErrs:    []error{nil},
+	}
+}
+
+func TestReconcileForum_InitialBuild(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-init"
+	res, err := svc.reconcileForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{mockThread("1", forumID, "u1", "alpha", false)},
+		[]*discordgo.Channel{mockThread("2", forumID, "u2", "beta", true)},
+	))
+	require.NoError(t, err)
+	assert.True(t, res.Initial)
+	assert.Len(t, res.Added, 2)
+
+	stats, _ := svc.Stats(forumID)
+	assert.Equal(t, 2, stats.Threads)
+	assert.Equal(t, 0, stats.Anomalies, "a cold build is not drift")
+	assert.False(t, stats.LastFullSync.IsZero())
+}
+
+func TestReconcileForum_FixesDrift(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-drift"
+	require.NoError(t, svc.refreshForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{
+			mockThread("1", forumID, "u1", "alpha", false),
+			mockThread("2", forumID, "u2", "beta", false),
+		},
+		[]*discordgo.Channel{mockThread("3", forumID, "u3", "gamma", true)},
+	)))
+
+	// Simulate missed gateway events: thread 2 was deleted, thread 4 was
+	// created and thread 3 was renamed, none of which reached the cache.
+	res, err := svc.reconcileForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{
+			mockThread("1", forumID, "u1", "alpha", false),
+			mockThread("4", forumID, "u2", "delta", false),
+		},
+		[]*discordgo.Channel{mockThread("3", forumID, "u3", "gamma two", true)},
+	))
+	require.NoError(t, err)
+	assert.False(t, res.Initial)
+	assert.False(t, res.Partial)
+	require.Len(t, res.Added, 1)
+	assert.Equal(t, "4", res.Added[0].ID)
+	require.Len(t, res.Removed, 1)
+	assert.Equal(t, "2", res.Removed[0].ID)
+	require.Len(t, res.Updated, 1)
+	assert.Equal(t, "3", res.Updated[0].ID)
+	assert.Equal(t, 3, res.Corrections())
+
+	_, ok := svc.GetThreadByExactName(forumID, "beta")
+	assert.False(t, ok, "phantom thread should be gone")
+	meta, ok := svc.GetThreadByExactName(forumID, "delta")
+	require.True(t, ok)
+	assert.Equal(t, "4", meta.ID)
+	latest, ok := svc.GetLatestUserThread(forumID, "u2")
+	require.True(t, ok)
+	assert.Equal(t, "4", latest.ID)
+	_, ok = svc.GetThreadByExactName(forumID, "gamma two")
+	assert.True(t, ok)
+
+	stats, _ := svc.Stats(forumID)
+	assert.Equal(t, 3, stats.Threads)
+	assert.Equal(t, 3, stats.Anomalies)
+	assert.Equal(t, 1, stats.EventAdds)
+	assert.Equal(t, 1, stats.EventDeletes)
+	assert.Equal(t, 1, stats.EventUpdates)
+
+	// A second pass with the same listing finds nothing to fix.
+	res, err = svc.reconcileForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{
+			mockThread("1", forumID, "u1", "alpha", false),
+			mockThread("4", forumID, "u2", "delta", false),
+		},
+		[]*discordgo.Channel{mockThread("3", forumID, "u3", "gamma two", true)},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.Corrections())
+}
+
+func TestReconcileForum_PartialListingKeepsUnseen(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-partial"
+	require.NoError(t, svc.refreshForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{mockThread("1", forumID, "u1", "alpha", false)},
+		[]*discordgo.Channel{mockThread("2", forumID, "u2", "old archived", true)},
+	)))
+
+	res, err := svc.reconcileForumWithLister("g", forumID, &mockLister{
+		active:          []*discordgo.Channel{mockThread("1", forumID, "u1", "alpha", false)},
+		archivedBatches: [][]*discordgo.Channel{nil},
+		archivedHasMore: []bool{false},
+		archivedErrs:    []error{assert.AnError},
+	})
+	require.NoError(t, err)
+	assert.True(t, res.Partial)
+	assert.Empty(t, res.Removed)
+	_, ok := svc.GetThreadByExactName(forumID, "old archived")
+	assert.True(t, ok, "unseen thread must survive a partial listing")
+}
+
+func TestReconcileForum_ActiveErrorLeavesCache(t *testing.T) {
+	_, svc := NewTestForumCache(nil)
+	forumID := "f-err"
+	require.NoError(t, svc.refreshForumWithLister("g", forumID, singlePageLister(
+		[]*discordgo.Channel{mockThread("1", forumID, "u1", "alpha", false)}, nil,
+	)))
+
+	_, err := svc.reconcileForumWithLister("g", forumID, &mockLister{activeErr: assert.AnError})
+	require.Error(t, err)
+	stats, _ := svc.Stats(forumID)
+	assert.Equal(t, 1, stats.Threads)
+	assert.Equal(t, 1, stats.FullSyncErrors)
+	assert.Equal(t, 0, stats.Anomalies)
+}
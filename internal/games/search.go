@@ -1,6 +1,7 @@
 package games
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -12,9 +13,21 @@ type GameSearchResult struct {
 	Suggestions []*igdb.Game
 }
 
+// defaultSearchLimit is how many results each IGDB query returns by default.
+const defaultSearchLimit = 10
+
 // ExactMatchWithSuggestions searches for a game by name and returns an exact match if found,
 // along with a list of suggested games for use if an exact match is not found.
 func ExactMatchWithSuggestions(igdbClient *igdb.Client, gameName string) (*GameSearchResult, error) {
+	return ExactMatchWithSuggestionsLimit(igdbClient, gameName, defaultSearchLimit)
+}
+
+// ExactMatchWithSuggestionsLimit is ExactMatchWithSuggestions with a custom
+// per-query result limit, for callers that page through more suggestions.
+func ExactMatchWithSuggestionsLimit(igdbClient *igdb.Client, gameName string, limit int) (*GameSearchResult, error) {
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
 	if igdbClient == nil {
 		return nil, fmt.Errorf("igdb client is nil")
 	}
@@ -29,16 +42,16 @@ func ExactMatchWithSuggestions(igdbClient *igdb.Client, gameName string) (*GameS
 	exacts, _ := igdbClient.Games.Index(
 		igdb.SetFields("id", "name", "summary", "websites", "multiplayer_modes", "cover", "release_dates", "first_release_date"),
 		igdb.SetFilter("name", igdb.OpEqualsCaseInsensitive, fmt.Sprintf(`"%s"`, gameName)),
-		igdb.SetLimit(10),
+		igdb.SetLimit(limit),
 	)
 	games = append(games, exacts...)
 
 	searchGames, err := igdbClient.Games.Search(gameName,
 		igdb.SetFields("id", "name", "summary", "websites", "multiplayer_modes", "cover", "release_dates", "first_release_date"),
-		igdb.SetLimit(10),
+		igdb.SetLimit(limit),
 		igdb.SetFilter("name", igdb.OpEqualsCaseInsensitive, fmt.Sprintf(`*"%s"*`, gameName)),
 	)
-	if err != nil {
+	if err != nil && !errors.Is(err, igdb.ErrNoResults) {
 		return nil, fmt.Errorf("igdb search error: %w", err)
 	}
 
@@ -74,3 +87,34 @@ func ExactMatchWithSuggestions(igdbClient *igdb.Client, gameName string) (*GameS
 
 	return &GameSearchResult{ExactMatch: exact, Suggestions: suggestions}, nil
 }
+
+// FuzzySearch runs IGDB's full-text search without the name filter used for
+// suggestions, so misspelled or partial titles still return candidates. It is
+// a fallback for when ExactMatchWithSuggestions finds nothing; results are in
+// IGDB's relevance order.
+func FuzzySearch(igdbClient *igdb.Client, gameName string, limit int) ([]*igdb.Game, error) {
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if igdbClient == nil {
+		return nil, fmt.Errorf("igdb client is nil")
+	}
+
+	gameName = strings.TrimSpace(gameName)
+	if gameName == "" {
+		return nil, fmt.Errorf("empty game name")
+	}
+
+	found, err := igdbClient.Games.Search(gameName,
+		igdb.SetFields("id", "name", "summary", "websites", "multiplayer_modes", "cover", "release_dates", "first_release_date"),
+		igdb.SetLimit(limit),
+	)
+	if err != nil {
+		// The igdb client reports an empty result set as an error.
+		if errors.Is(err, igdb.ErrNoResults) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("igdb fuzzy search error: %w", err)
+	}
+	return found, nil
+}
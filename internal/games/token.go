@@ -0,0 +1,170 @@
+package games
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// twitchTokenURL is the OAuth endpoint that issues IGDB app access tokens.
+const twitchTokenURL = "https://id.twitch.tv/oauth2/token"
+
+// TokenManager holds the current IGDB app access token and refreshes it from
+// Twitch. The IGDB client sends requests through HTTPClient, which stamps the
+// current token on each one, so a refresh takes effect everywhere without
+// recreating the client.
+type TokenManager struct {
+	clientID string
+	secret   string
+
+	refreshMu sync.Mutex // serializes refreshes
+
+	mu        sync.RWMutex
+	token     string
+	expiresAt time.Time // zero when unknown (e.g. a token supplied via config)
+
+	// test hooks
+	fetch func(clientID, secret string) (token string, expiresIn int, err error)
+	now   func() time.Time
+}
+
+// NewTokenManager creates a manager seeded with a configured token whose
+// expiry is unknown.
+func NewTokenManager(clientID, secret, token string) *TokenManager {
+	return &TokenManager{
+		clientID: clientID,
+		secret:   secret,
+		token:    token,
+		fetch:    FetchTwitchAppToken,
+		now:      time.Now,
+	}
+}
+
+// Token returns the current access token.
+func (t *TokenManager) Token() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.token
+}
+
+// ExpiresAt returns when the current token expires, or zero if unknown.
+func (t *TokenManager) ExpiresAt() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.expiresAt
+}
+
+// CanRefresh reports whether a client secret is configured.
+func (t *TokenManager) CanRefresh() bool {
+	return t.clientID != "" && t.secret != ""
+}
+
+// Refresh fetches a new token and returns how long it is valid for.
+func (t *TokenManager) Refresh() (time.Duration, error) {
+	t.refreshMu.Lock()
+	defer t.refreshMu.Unlock()
+	return t.refreshLocked()
+}
+
+// RefreshIfExpiring refreshes the token when it expires within margin or its
+// expiry is unknown. It reports whether a refresh happened. A refresh already
+// in progress is waited on rather than repeated.
+func (t *TokenManager) RefreshIfExpiring(margin time.Duration) (bool, error) {
+	t.refreshMu.Lock()
+	defer t.refreshMu.Unlock()
+	exp := t.ExpiresAt()
+	if !exp.IsZero() && t.now().Add(margin).Before(exp) {
+		return false, nil
+	}
+	if _, err := t.refreshLocked(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (t *TokenManager) refreshLocked() (time.Duration, error) {
+	if !t.CanRefresh() {
+		return 0, errors.New("missing igdb_client_id or igdb_client_secret")
+	}
+	token, expiresIn, err := t.fetch(t.clientID, t.secret)
+	if err != nil {
+		return 0, err
+	}
+	ttl := time.Duration(expiresIn) * time.Second
+	t.mu.Lock()
+	t.token = token
+	t.expiresAt = t.now().Add(ttl)
+	t.mu.Unlock()
+	return ttl, nil
+}
+
+// HTTPClient returns a client for igdb.NewClient that sends the current token
+// with every request.
+func (t *TokenManager) HTTPClient() *http.Client {
+	return &http.Client{Transport: &tokenTransport{tokens: t, base: http.DefaultTransport}}
+}
+
+// tokenTransport overwrites the Authorization header the IGDB client set at
+// construction time with the manager's current token.
+type tokenTransport struct {
+	tokens *TokenManager
+	base   http.RoundTripper
+}
+
+func (tt *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tt.tokens.Token())
+	return tt.base.RoundTrip(req)
+}
+
+// FetchTwitchAppToken requests a new app access token from Twitch/IGDB.
+func FetchTwitchAppToken(clientID, clientSecret string) (token string, expiresIn int, err error) {
+	u, err := url.Parse(twitchTokenURL)
+	if err != nil {
+		return "", 0, err
+	}
+	q := u.Query()
+	q.Set("client_id", clientID)
+	q.Set("client_secret", clientSecret)
+	q.Set("grant_type", "client_credentials")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), nil)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		bodyStr := string(body)
+		if len(bodyStr) > 200 {
+			bodyStr = bodyStr[:200] + "..."
+		}
+		return "", 0, fmt.Errorf("twitch token endpoint returned %d: %s", resp.StatusCode, bodyStr)
+	}
+
+	var parsed struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", 0, err
+	}
+	if parsed.AccessToken == "" {
+		return "", 0, fmt.Errorf("empty access_token in response")
+	}
+	return parsed.AccessToken, parsed.ExpiresIn, nil
+}
@@ -0,0 +1,102 @@
+package games
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenManager(fetch func() (string, int, error)) (*TokenManager, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tm := NewTokenManager("id", "secret", "initial")
+	tm.fetch = func(string, string) (string, int, error) { return fetch() }
+	tm.now = func() time.Time { return now }
+	return tm, &now
+}
+
+func TestTokenManagerRefreshIfExpiring(t *testing.T) {
+	calls := 0
+	tm, now := newTestTokenManager(func() (string, int, error) {
+		calls++
+		return "fresh", int((48 * time.Hour).Seconds()), nil
+	})
+
+	// A configured token has no known expiry, so the first check refreshes.
+	refreshed, err := tm.RefreshIfExpiring(24 * time.Hour)
+	require.NoError(t, err)
+	require.True(t, refreshed)
+	require.Equal(t, "fresh", tm.Token())
+	require.Equal(t, now.Add(48*time.Hour), tm.ExpiresAt())
+
+	refreshed, err = tm.RefreshIfExpiring(24 * time.Hour)
+	require.NoError(t, err)
+	require.False(t, refreshed, "still more than a margin away from expiry")
+
+	*now = now.Add(25 * time.Hour)
+	refreshed, err = tm.RefreshIfExpiring(24 * time.Hour)
+	require.NoError(t, err)
+	require.True(t, refreshed)
+	require.Equal(t, 2, calls)
+}
+
+func TestTokenManagerRefreshFailureKeepsToken(t *testing.T) {
+	tm, _ := newTestTokenManager(func() (string, int, error) { return "", 0, errors.New("twitch down") })
+	_, err := tm.Refresh()
+	require.ErrorContains(t, err, "twitch down")
+	require.Equal(t, "initial", tm.Token())
+
+	noSecret := NewTokenManager("id", "", "initial")
+	require.False(t, noSecret.CanRefresh())
+	_, err = noSecret.Refresh()
+	require.Error(t, err)
+}
+
+func TestTokenManagerConcurrentRefreshes(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	tm, _ := newTestTokenManager(func() (string, int, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return "fresh", 3600, nil
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() { _, _ = tm.RefreshIfExpiring(time.Minute) })
+	}
+	wg.Wait()
+	require.Equal(t, 1, calls, "waiters see the refreshed token instead of refreshing again")
+}
+
+func TestTokenManagerHTTPClientUsesCurrentToken(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+
+	tm, _ := newTestTokenManager(func() (string, int, error) { return "fresh", 3600, nil })
+	client := tm.HTTPClient()
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+	req.Header.Add("Authorization", "Bearer stale")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	_, err = tm.Refresh()
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	require.Equal(t, []string{"Bearer initial", "Bearer fresh"}, got)
+}
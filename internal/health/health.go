@@ -0,0 +1,100 @@
+// Package health serves liveness and readiness probes for container
+// orchestrators over a minimal embedded HTTP server.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// Check reports whether one readiness condition holds. A non-nil error
+// explains why the bot is not ready.
+type Check func() error
+
+// NamedCheck pairs a readiness check with the name shown in /readyz output.
+type NamedCheck struct {
+	Name  string
+	Check Check
+}
+
+// Server exposes /healthz (the process is up and serving) and /readyz (every
+// readiness check passes). Additional handlers can be mounted with Handle
+// before Start.
+type Server struct {
+	addr   string
+	mux    *http.ServeMux
+	checks []NamedCheck
+	srv    *http.Server
+	logger *log.Logger
+}
+
+// NewServer creates a server listening on addr (e.g. ":8080") once started.
+func NewServer(addr string, logger *log.Logger, checks ...NamedCheck) *Server {
+	s := &Server{addr: addr, mux: http.NewServeMux(), checks: checks, logger: logger}
+	s.mux.HandleFunc("/healthz", s.handleHealthz)
+	s.mux.HandleFunc("/readyz", s.handleReadyz)
+	return s
+}
+
+// Handle mounts an extra handler on the server's mux.
+func (s *Server) Handle(pattern string, h http.Handler) {
+	s.mux.Handle(pattern, h)
+}
+
+// Handler returns the server's request handler, for tests.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Start binds the listener and serves in the background. Binding errors are
+// returned; later serve errors are logged.
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+	s.srv = &http.Server{Handler: s.mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Errorf("health server stopped: %v", err)
+		}
+	}()
+	s.logger.Infof("Health server listening on %s", ln.Addr())
+	return nil
+}
+
+// Shutdown stops the server, waiting for in-flight probes until ctx is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Shutdown(ctx)
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	var failures []string
+	for _, c := range s.checks {
+		if err := c.Check(); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", c.Name, err))
+		}
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strings.Join(failures, "\n") + "\n"))
+		return
+	}
+	_, _ = w.Write([]byte("ok\n"))
+}
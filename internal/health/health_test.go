@@ -0,0 +1,56 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, h http.Handler, path string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestProbes(t *testing.T) {
+	gatewayErr := errors.New("gateway not connected")
+	srv := NewServer(":0", log.New(os.Stderr),
+		NamedCheck{Name: "gateway", Check: func() error { return gatewayErr }},
+		NamedCheck{Name: "forum_sync", Check: func() error { return nil }},
+	)
+
+	code, body := get(t, srv.Handler(), "/healthz")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok\n", body)
+
+	code, body = get(t, srv.Handler(), "/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "gateway: gateway not connected\n", body)
+
+	gatewayErr = nil
+	code, body = get(t, srv.Handler(), "/readyz")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok\n", body)
+
+	code, _ = get(t, srv.Handler(), "/nope")
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestStartAndShutdown(t *testing.T) {
+	srv := NewServer("127.0.0.1:0", log.New(io.Discard))
+	require.NoError(t, srv.Start())
+	require.NoError(t, srv.Shutdown(context.Background()))
+
+	// Shutdown before Start is a no-op.
+	require.NoError(t, NewServer(":0", log.New(io.Discard)).Shutdown(context.Background()))
+}
@@ -1,21 +1,47 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"gamerpal/internal/config"
 	"gamerpal/internal/database"
 	"gamerpal/internal/utils"
+	"math/rand/v2"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/robfig/cron/v3"
 )
 
-// Scheduler handles periodic execution of scheduled tasks using cron
+// defaultMaxJitter is the upper bound of the random delay added before each
+// job run so jobs sharing a schedule don't hit the Discord API in one burst.
+const defaultMaxJitter = 5 * time.Second
+
+// clock is the subset of time functionality the scheduler depends on,
+// swappable in tests.
+type clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Scheduler handles periodic execution of scheduled tasks using cron.
+// Jobs are recovered from panics, skipped if their previous run is still in
+// progress, delayed by a small random jitter, and cancelled via context when
+// the scheduler stops.
 type Scheduler struct {
 	session *discordgo.Session
 	config  *config.Config
 	db      *database.DB
 	cron    *cron.Cron
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	clock     clock
+	maxJitter time.Duration
+	jitter    func(limit time.Duration) time.Duration
 }
 
 // NewScheduler creates a new scheduler instance
@@ -30,11 +56,17 @@ func NewScheduler(session *discordgo.Session, cfg *config.Config, db *database.D
 		),
 	)
 
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Scheduler{
-		session: session,
-		config:  cfg,
-		db:      db,
-		cron:    c,
+		session:   session,
+		config:    cfg,
+		db:        db,
+		cron:      c,
+		ctx:       ctx,
+		cancel:    cancel,
+		clock:     realClock{},
+		maxJitter: defaultMaxJitter,
+		jitter:    randomJitter,
 	}
 }
 
@@ -43,19 +75,13 @@ func NewScheduler(session *discordgo.Session, cfg *config.Config, db *database.D
 // name: descriptive name for logging purposes
 // fn: function to execute on schedule
 func (s *Scheduler) RegisterFunc(schedule, name string, fn func() error) error {
-	// Wrap the function to handle errors
-	wrappedFunc := func() {
-		err := fn()
-		if err != nil {
-			s.config.Logger.Errorf("Error occurred executing scheduled job '%s': %v", name, err)
-			logErr := utils.LogToChannel(s.config, s.session, fmt.Sprintf("Error in scheduled job '%s': %v", name, err))
-			if logErr != nil {
-				s.config.Logger.Errorf("Failed to log error to channel: %v", logErr)
-			}
-		}
-	}
+	return s.RegisterContextFunc(schedule, name, func(context.Context) error { return fn() })
+}
 
-	_, err := s.cron.AddFunc(schedule, wrappedFunc)
+// RegisterContextFunc is RegisterFunc for jobs that can stop early. The
+// context is cancelled when the scheduler stops.
+func (s *Scheduler) RegisterContextFunc(schedule, name string, fn func(ctx context.Context) error) error {
+	_, err := s.cron.AddFunc(schedule, s.wrap(name, fn))
 	if err != nil {
 		return fmt.Errorf("failed to register scheduled job '%s' with schedule '%s': %w", name, schedule, err)
 	}
@@ -64,6 +90,31 @@ func (s *Scheduler) RegisterFunc(schedule, name string, fn func() error) error {
 	return nil
 }
 
+// wrap applies jitter and cancellation to fn and reports its errors.
+func (s *Scheduler) wrap(name string, fn func(ctx context.Context) error) func() {
+	return func() {
+		if d := s.jitter(s.maxJitter); d > 0 {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-s.clock.After(d):
+			}
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+
+		err := fn(s.ctx)
+		if err != nil && s.ctx.Err() == nil {
+			s.config.Logger.Errorf("Error occurred executing scheduled job '%s': %v", name, err)
+			logErr := utils.LogToChannel(s.config, s.session, fmt.Sprintf("Error in scheduled job '%s': %v", name, err))
+			if logErr != nil {
+				s.config.Logger.Errorf("Failed to log error to channel: %v", logErr)
+			}
+		}
+	}
+}
+
 // Start starts the scheduler
 func (s *Scheduler) Start() {
 	s.config.Logger.Info("Cron scheduler starting...")
@@ -71,14 +122,23 @@ func (s *Scheduler) Start() {
 	s.config.Logger.Info("Cron scheduler started!")
 }
 
-// Stop stops the scheduler gracefully
+// Stop cancels running jobs' context and waits for them to return.
 func (s *Scheduler) Stop() {
 	s.config.Logger.Info("Cron scheduler stopping...")
+	s.cancel()
 	ctx := s.cron.Stop()
 	<-ctx.Done()
 	s.config.Logger.Info("Cron scheduler stopped")
 }
 
+// randomJitter returns a uniformly random duration in [0, limit).
+func randomJitter(limit time.Duration) time.Duration {
+	if limit <= 0 {
+		return 0
+	}
+	return rand.N(limit)
+}
+
 // cronLogger adapts our config logger to cron's Logger interface
 type cronLogger struct {
 	logger interface {
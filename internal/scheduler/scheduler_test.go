@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gamerpal/internal/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock hands out channels the test fires manually.
+type fakeClock struct {
+	waits chan time.Duration
+	fire  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{waits: make(chan time.Duration, 1), fire: make(chan time.Time)}
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.waits <- d
+	return f.fire
+}
+
+func newTestScheduler(t *testing.T) (*Scheduler, *fakeClock) {
+	t.Helper()
+	s := NewScheduler(nil, config.NewMockConfig(map[string]any{}), nil)
+	t.Cleanup(s.cancel)
+	fc := newFakeClock()
+	s.clock = fc
+	s.jitter = func(limit time.Duration) time.Duration { return limit / 2 }
+	return s, fc
+}
+
+func TestWrapWaitsForJitter(t *testing.T) {
+	s, fc := newTestScheduler(t)
+	ran := make(chan struct{})
+	job := s.wrap("test", func(context.Context) error { close(ran); return nil })
+
+	done := make(chan struct{})
+	go func() { job(); close(done) }()
+
+	require.Equal(t, defaultMaxJitter/2, <-fc.waits)
+	select {
+	case <-ran:
+		t.Fatal("job ran before jitter elapsed")
+	default:
+	}
+
+	fc.fire <- time.Now()
+	<-done
+	select {
+	case <-ran:
+	default:
+		t.Fatal("job did not run after jitter elapsed")
+	}
+}
+
+func TestWrapCancelledDuringJitterSkipsJob(t *testing.T) {
+	s, fc := newTestScheduler(t)
+	ran := false
+	job := s.wrap("test", func(context.Context) error { ran = true; return nil })
+
+	done := make(chan struct{})
+	go func() { job(); close(done) }()
+	<-fc.waits
+	s.cancel()
+	<-done
+	require.False(t, ran)
+}
+
+func TestWrapPassesCancellableContext(t *testing.T) {
+	s, _ := newTestScheduler(t)
+	s.jitter = func(time.Duration) time.Duration { return 0 }
+
+	var jobCtx context.Context
+	s.wrap("test", func(ctx context.Context) error { jobCtx = ctx; return nil })()
+	require.NoError(t, jobCtx.Err())
+
+	s.cancel()
+	require.ErrorIs(t, jobCtx.Err(), context.Canceled)
+}
+
+func TestRandomJitterBounds(t *testing.T) {
+	require.Zero(t, randomJitter(0))
+	for range 100 {
+		d := randomJitter(time.Second)
+		require.GreaterOrEqual(t, d, time.Duration(0))
+		require.Less(t, d, time.Second)
+	}
+}
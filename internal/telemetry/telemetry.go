@@ -0,0 +1,192 @@
+// Package telemetry is a minimal metrics registry that renders counters and
+// scrape-time gauges in the Prometheus text exposition format. It has no
+// external dependencies; a nil *CounterVec is a valid no-op so call sites
+// cost nothing when metrics are disabled.
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Kind is the Prometheus metric type written in the # TYPE line.
+type Kind string
+
+const (
+	KindCounter Kind = "counter"
+	KindGauge   Kind = "gauge"
+)
+
+// Sample is one labeled value produced by a scrape-time func. LabelValues
+// are positional, matching the label names the func was registered with.
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// metric is anything the registry can render.
+type metric interface {
+	meta() (name, help string, kind Kind, labels []string)
+	samples() []Sample
+}
+
+// Registry holds every registered metric in registration order.
+type Registry struct {
+	mu      sync.Mutex
+	metrics []metric
+	names   map[string]struct{}
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]struct{})}
+}
+
+func (r *Registry) register(m metric) {
+	name, _, _, _ := m.meta()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, dup := r.names[name]; dup {
+		panic(fmt.Sprintf("telemetry: metric %q registered twice", name))
+	}
+	r.names[name] = struct{}{}
+	r.metrics = append(r.metrics, m)
+}
+
+// CounterVec is a monotonically increasing counter partitioned by labels.
+type CounterVec struct {
+	name, help string
+	labels     []string
+	mu         sync.Mutex
+	values     map[string]*Sample // keyed by joined label values
+}
+
+// NewCounterVec registers a counter with the given label names.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{name: name, help: help, labels: labels, values: make(map[string]*Sample)}
+	r.register(c)
+	return c
+}
+
+// Inc adds one to the series for labelValues. Safe on a nil receiver.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v (which must not be negative) to the series for labelValues.
+// Safe on a nil receiver.
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	if c == nil || v < 0 {
+		return
+	}
+	if len(labelValues) != len(c.labels) {
+		panic(fmt.Sprintf("telemetry: %s expects %d label values, got %d", c.name, len(c.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\x00")
+	c.mu.Lock()
+	s, ok := c.values[key]
+	if !ok {
+		s = &Sample{LabelValues: append([]string(nil), labelValues...)}
+		c.values[key] = s
+	}
+	s.Value += v
+	c.mu.Unlock()
+}
+
+func (c *CounterVec) meta() (string, string, Kind, []string) {
+	return c.name, c.help, KindCounter, c.labels
+}
+
+func (c *CounterVec) samples() []Sample {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]Sample, 0, len(c.values))
+	for _, s := range c.values {
+		out = append(out, *s)
+	}
+	return out
+}
+
+// funcMetric reads its samples from a callback at scrape time.
+type funcMetric struct {
+	name, help string
+	kind       Kind
+	labels     []string
+	fn         func() []Sample
+}
+
+func (f *funcMetric) meta() (string, string, Kind, []string) {
+	return f.name, f.help, f.kind, f.labels
+}
+
+func (f *funcMetric) samples() []Sample { return f.fn() }
+
+// GaugeFunc registers a gauge whose samples are computed on each scrape.
+func (r *Registry) GaugeFunc(name, help string, labels []string, fn func() []Sample) {
+	r.register(&funcMetric{name: name, help: help, kind: KindGauge, labels: labels, fn: fn})
+}
+
+// CounterFunc registers a counter whose samples are read on each scrape, for
+// totals another component already tracks.
+func (r *Registry) CounterFunc(name, help string, labels []string, fn func() []Sample) {
+	r.register(&funcMetric{name: name, help: help, kind: KindCounter, labels: labels, fn: fn})
+}
+
+// WriteText renders every metric in the Prometheus text format. Series
+// within a metric are sorted by label values for stable output.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	metrics := append([]metric(nil), r.metrics...)
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		name, help, kind, labels := m.meta()
+		samples := m.samples()
+		sort.Slice(samples, func(i, j int) bool {
+			return strings.Join(samples[i].LabelValues, "\x00") < strings.Join(samples[j].LabelValues, "\x00")
+		})
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, kind)
+		for _, s := range samples {
+			bw.WriteString(name)
+			if len(labels) > 0 {
+				bw.WriteByte('{')
+				for i, l := range labels {
+					if i > 0 {
+						bw.WriteByte(',')
+					}
+					v := ""
+					if i < len(s.LabelValues) {
+						v = s.LabelValues[i]
+					}
+					fmt.Fprintf(bw, "%s=\"%s\"", l, escapeLabel(v))
+				}
+				bw.WriteByte('}')
+			}
+			bw.WriteByte(' ')
+			bw.WriteString(strconv.FormatFloat(s.Value, 'g', -1, 64))
+			bw.WriteByte('\n')
+		}
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP serves the registry for a Prometheus scrape.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = r.WriteText(w)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
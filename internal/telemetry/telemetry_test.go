@@ -0,0 +1,88 @@
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	cmds := r.NewCounterVec("app_commands_total", "Commands handled.", "command")
+	cmds.Inc("ping")
+	cmds.Inc("ping")
+	cmds.Add(3, "say")
+	cmds.Add(-1, "say") // counters never go down
+
+	r.GaugeFunc("app_queue_depth", "Pending items.", nil, func() []Sample {
+		return []Sample{{Value: 7}}
+	})
+	r.CounterFunc("app_events_total", "Events by kind.", []string{"forum", "event"}, func() []Sample {
+		return []Sample{
+			{LabelValues: []string{"f2", "add"}, Value: 1},
+			{LabelValues: []string{"f1", "add"}, Value: 2.5},
+		}
+	})
+	esc := r.NewCounterVec("app_escaped_total", "Line one\nline two.", "value")
+	esc.Inc(`a "quoted" \ value`)
+
+	var b strings.Builder
+	require.NoError(t, r.WriteText(&b))
+	want := `# HELP app_commands_total Commands handled.
+# TYPE app_commands_total counter
+app_commands_total{command="ping"} 2
+app_commands_total{command="say"} 3
+# HELP app_queue_depth Pending items.
+# TYPE app_queue_depth gauge
+app_queue_depth 7
+# HELP app_events_total Events by kind.
+# TYPE app_events_total counter
+app_events_total{forum="f1",event="add"} 2.5
+app_events_total{forum="f2",event="add"} 1
+# HELP app_escaped_total Line one\nline two.
+# TYPE app_escaped_total counter
+app_escaped_total{value="a \"quoted\" \\ value"} 1
+`
+	require.Equal(t, want, b.String())
+}
+
+func TestNilCounterIsNoop(t *testing.T) {
+	var c *CounterVec
+	require.NotPanics(t, func() {
+		c.Inc("x")
+		c.Add(2, "y")
+	})
+}
+
+func TestDuplicateAndLabelMismatchPanic(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("dup_total", "x", "a")
+	require.Panics(t, func() { r.NewCounterVec("dup_total", "x") })
+	require.Panics(t, func() { c.Inc() })
+}
+
+func TestConcurrentIncAndServe(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("hits_total", "Hits.", "path")
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 500 {
+				c.Inc("/")
+			}
+		}()
+	}
+	wg.Wait()
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "version=0.0.4")
+	require.Contains(t, rec.Body.String(), `hits_total{path="/"} 4000`)
+}
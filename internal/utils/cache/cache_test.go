@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f *fakeClock) now() time.Time          { return f.t }
+func (f *fakeClock) advance(d time.Duration) { f.t = f.t.Add(d) }
+
+func TestEvictionOrder(t *testing.T) {
+	c := New[string, int](3, 0)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	// Touch "a" so "b" becomes the least recently used
+	_, ok := c.Get("a")
+	require.True(t, ok)
+
+	c.Set("d", 4)
+	_, ok = c.Get("b")
+	require.False(t, ok, "b should have been evicted")
+	for _, k := range []string{"a", "c", "d"} {
+		_, ok := c.Get(k)
+		require.True(t, ok, k)
+	}
+
+	// Updating an existing key refreshes recency without growing the cache
+	c.Set("a", 10)
+	c.Set("e", 5)
+	_, ok = c.Get("c")
+	require.False(t, ok, "c should have been evicted")
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 10, v)
+	require.Equal(t, 3, c.Len())
+	require.Equal(t, uint64(2), c.Stats().Evictions)
+}
+
+func TestTTLExpiry(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := New[string, string](0, time.Minute)
+	c.SetClock(clock.now)
+
+	c.Set("k", "v")
+	clock.advance(59 * time.Second)
+	v, ok := c.Get("k")
+	require.True(t, ok)
+	require.Equal(t, "v", v)
+
+	clock.advance(time.Second)
+	_, ok = c.Get("k")
+	require.False(t, ok)
+	require.Equal(t, 0, c.Len(), "expired entries are dropped on access")
+
+	// Set refreshes the TTL
+	c.Set("k", "v1")
+	clock.advance(45 * time.Second)
+	c.Set("k", "v2")
+	clock.advance(45 * time.Second)
+	v, ok = c.Get("k")
+	require.True(t, ok)
+	require.Equal(t, "v2", v)
+}
+
+func TestTakeAndDelete(t *testing.T) {
+	c := New[int, string](0, 0)
+	c.Set(1, "one")
+	c.Set(2, "two")
+
+	v, ok := c.Take(1)
+	require.True(t, ok)
+	require.Equal(t, "one", v)
+	_, ok = c.Take(1)
+	require.False(t, ok)
+
+	c.Delete(2)
+	c.Delete(3) // missing keys are a no-op
+	require.Equal(t, 0, c.Len())
+}
+
+func TestStats(t *testing.T) {
+	c := New[string, int](1, 0)
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Get("missing")
+	c.Set("b", 2)
+
+	s := c.Stats()
+	require.Equal(t, Stats{Hits: 2, Misses: 1, Evictions: 1, Size: 1}, s)
+	require.InDelta(t, 2.0/3.0, s.HitRatio(), 1e-9)
+	require.Zero(t, Stats{}.HitRatio())
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	c := New[string, int](64, time.Hour)
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := fmt.Sprintf("k%d", (g*31+i)%128)
+				switch i % 4 {
+				case 0:
+					c.Delete(key)
+				case 1:
+					c.Get(key)
+				default:
+					c.Set(key, i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, c.Len(), 64)
+	s := c.Stats()
+	require.Equal(t, uint64(8*250), s.Hits+s.Misses)
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"gamerpal/internal/config"
+	"io"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// deferredEditAttempts bounds how many times a deferred response edit is
+	// tried before falling back to a followup message
+	deferredEditAttempts = 3
+	// deferredEditBackoff is the wait before the second attempt; it doubles after
+	deferredEditBackoff = 500 * time.Millisecond
+)
+
+// Swapped out in tests.
+var (
+	deferredEdit = func(s *discordgo.Session, i *discordgo.Interaction, edit *discordgo.WebhookEdit) error {
+		_, err := s.InteractionResponseEdit(i, edit)
+		return err
+	}
+	deferredFollowup = func(s *discordgo.Session, i *discordgo.Interaction, params *discordgo.WebhookParams) error {
+		_, err := s.FollowupMessageCreate(i, true, params)
+		return err
+	}
+	deferredSleep = time.Sleep
+)
+
+// EditDeferredResponse replaces a deferred "thinking…" response with edit,
+// retrying a couple of times on failure. If every attempt fails it posts the
+// same content as a followup (ephemeral when the deferral was) so the user
+// isn't left waiting forever. Failures are logged; the returned error is
+// non-nil only when the fallback failed too.
+func EditDeferredResponse(cfg *config.Config, s *discordgo.Session, i *discordgo.Interaction, edit *discordgo.WebhookEdit, ephemeral bool) error {
+	var err error
+	for attempt := range deferredEditAttempts {
+		if attempt > 0 {
+			deferredSleep(deferredEditBackoff << (attempt - 1))
+		}
+		rewindFiles(edit.Files)
+		if err = deferredEdit(s, i, edit); err == nil {
+			return nil
+		}
+	}
+	cfg.Logger.Warnf("Failed to edit deferred response after %d attempts, sending followup: %v", deferredEditAttempts, err)
+
+	params := &discordgo.WebhookParams{Files: edit.Files}
+	if edit.Content != nil {
+		params.Content = *edit.Content
+	}
+	if edit.Embeds != nil {
+		params.Embeds = *edit.Embeds
+	}
+	if edit.Components != nil {
+		params.Components = *edit.Components
+	}
+	if ephemeral {
+		params.Flags = discordgo.MessageFlagsEphemeral
+	}
+	rewindFiles(params.Files)
+	if ferr := deferredFollowup(s, i, params); ferr != nil {
+		cfg.Logger.Errorf("Failed to send followup for deferred response: %v", ferr)
+		return fmt.Errorf("failed to deliver deferred response: %w", ferr)
+	}
+	return nil
+}
+
+// rewindFiles seeks attachment readers back to the start so a retried
+// request re-sends the full file.
+func rewindFiles(files []*discordgo.File) {
+	for _, f := range files {
+		if seeker, ok := f.Reader.(io.Seeker); ok {
+			_, _ = seeker.Seek(0, io.SeekStart)
+		}
+	}
+}
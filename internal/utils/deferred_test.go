@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"gamerpal/internal/config"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDeferred replaces the edit/followup/sleep hooks and restores them after the test.
+func stubDeferred(t *testing.T, edit func(*discordgo.WebhookEdit) error, followup func(*discordgo.WebhookParams) error) *[]time.Duration {
+	t.Helper()
+	origEdit, origFollowup, origSleep := deferredEdit, deferredFollowup, deferredSleep
+	t.Cleanup(func() { deferredEdit, deferredFollowup, deferredSleep = origEdit, origFollowup, origSleep })
+	var waits []time.Duration
+	deferredSleep = func(d time.Duration) { waits = append(waits, d) }
+	deferredEdit = func(_ *discordgo.Session, _ *discordgo.Interaction, e *discordgo.WebhookEdit) error { return edit(e) }
+	deferredFollowup = func(_ *discordgo.Session, _ *discordgo.Interaction, p *discordgo.WebhookParams) error {
+		return followup(p)
+	}
+	return &waits
+}
+
+func TestEditDeferredResponse(t *testing.T) {
+	cfg := config.NewMockConfig(nil)
+	content := "done"
+
+	t.Run("retries then succeeds and rewinds files", func(t *testing.T) {
+		calls := 0
+		var sent [][]byte
+		waits := stubDeferred(t, func(e *discordgo.WebhookEdit) error {
+			calls++
+			body, _ := io.ReadAll(e.Files[0].Reader)
+			sent = append(sent, body)
+			if calls == 1 {
+				return errors.New("connection reset")
+			}
+			return nil
+		}, func(*discordgo.WebhookParams) error {
+			t.Fatal("followup should not be sent")
+			return nil
+		})
+		edit := &discordgo.WebhookEdit{Content: &content, Files: []*discordgo.File{{Name: "r.csv", Reader: bytes.NewReader([]byte("a,b"))}}}
+
+		require.NoError(t, EditDeferredResponse(cfg, &discordgo.Session{}, &discordgo.Interaction{}, edit, false))
+		require.Equal(t, 2, calls)
+		require.Equal(t, [][]byte{[]byte("a,b"), []byte("a,b")}, sent)
+		require.Equal(t, []time.Duration{deferredEditBackoff}, *waits)
+	})
+
+	t.Run("falls back to followup", func(t *testing.T) {
+		calls := 0
+		var followup *discordgo.WebhookParams
+		waits := stubDeferred(t, func(*discordgo.WebhookEdit) error {
+			calls++
+			return errors.New("unknown webhook")
+		}, func(p *discordgo.WebhookParams) error {
+			followup = p
+			return nil
+		})
+		embeds := []*discordgo.MessageEmbed{{Title: "Results"}}
+		edit := &discordgo.WebhookEdit{Content: &content, Embeds: &embeds}
+
+		require.NoError(t, EditDeferredResponse(cfg, &discordgo.Session{}, &discordgo.Interaction{}, edit, true))
+		require.Equal(t, deferredEditAttempts, calls)
+		require.Equal(t, []time.Duration{deferredEditBackoff, 2 * deferredEditBackoff}, *waits)
+		require.NotNil(t, followup)
+		require.Equal(t, "done", followup.Content)
+		require.Equal(t, embeds, followup.Embeds)
+		require.Equal(t, discordgo.MessageFlagsEphemeral, followup.Flags)
+	})
+
+	t.Run("reports when the followup fails too", func(t *testing.T) {
+		stubDeferred(t, func(*discordgo.WebhookEdit) error { return errors.New("down") },
+			func(*discordgo.WebhookParams) error { return errors.New("still down") })
+		err := EditDeferredResponse(cfg, &discordgo.Session{}, &discordgo.Interaction{}, &discordgo.WebhookEdit{Content: &content}, false)
+		require.ErrorContains(t, err, "still down")
+	})
+}
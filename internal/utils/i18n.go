@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// MessageID identifies a translatable user-facing string.
+type MessageID string
+
+// Translations maps a Discord locale to a fmt format string. Every entry must
+// include discordgo.EnglishUS, which is the fallback for missing locales.
+type Translations map[discordgo.Locale]string
+
+// catalog holds every translatable message. Feature strings are declared in
+// i18n_<feature>.go files so each feature's text stays together; add new
+// feature maps here.
+var catalog = mergeMessages(lfgMessages)
+
+// mergeMessages combines feature message maps, panicking on a duplicate ID
+// since that is a programming error caught by any test run.
+func mergeMessages(sets ...map[MessageID]Translations) map[MessageID]Translations {
+	out := make(map[MessageID]Translations)
+	for _, set := range sets {
+		for id, tr := range set {
+			if _, dup := out[id]; dup {
+				panic(fmt.Sprintf("i18n: duplicate message id %q", id))
+			}
+			out[id] = tr
+		}
+	}
+	return out
+}
+
+// T renders message id for locale, formatting args into it. The lookup tries
+// the exact locale, then any locale with the same language (so es-419 can use
+// es-ES), then English. An unknown id renders as the id itself so a missing
+// entry is obvious rather than blank.
+func T(locale discordgo.Locale, id MessageID, args ...any) string {
+	tr, ok := catalog[id]
+	if !ok {
+		return string(id)
+	}
+	format, ok := tr[locale]
+	if !ok {
+		format, ok = sameLanguage(tr, locale)
+	}
+	if !ok {
+		format = tr[discordgo.EnglishUS]
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// sameLanguage finds a translation for another regional variant of locale's
+// language, preferring the lexically smallest locale for determinism.
+func sameLanguage(tr Translations, locale discordgo.Locale) (string, bool) {
+	lang, _, _ := strings.Cut(string(locale), "-")
+	if lang == "" {
+		return "", false
+	}
+	var best discordgo.Locale
+	for l := range tr {
+		if l2, _, _ := strings.Cut(string(l), "-"); l2 == lang && (best == "" || l < best) {
+			best = l
+		}
+	}
+	if best == "" {
+		return "", false
+	}
+	return tr[best], true
+}
@@ -0,0 +1,114 @@
+package utils
+
+import "github.com/bwmarrin/discordgo"
+
+// LFG user-facing messages.
+const (
+	MsgLFGForumNotConfigured     MessageID = "lfg.forum_not_configured"
+	MsgLFGSearchQueryRequired    MessageID = "lfg.search_query_required"
+	MsgLFGGameNameRequired       MessageID = "lfg.game_name_required"
+	MsgLFGNoMoreSuggestions      MessageID = "lfg.no_more_suggestions"
+	MsgLFGInvalidSuggestion      MessageID = "lfg.invalid_suggestion"
+	MsgLFGGameDetailsUnavailable MessageID = "lfg.game_details_unavailable"
+	MsgLFGGameHasNoName          MessageID = "lfg.game_has_no_name"
+	MsgLFGGameLookupUnavailable  MessageID = "lfg.game_lookup_unavailable"
+	MsgLFGThreadRateLimited      MessageID = "lfg.thread_rate_limited"
+	MsgLFGThreadCreateFailed     MessageID = "lfg.thread_create_failed"
+	MsgLFGNowMessageRequired     MessageID = "lfg.now.message_required"
+	MsgLFGNowInvalidPlayerCount  MessageID = "lfg.now.invalid_player_count"
+	MsgLFGNowInvalidVoiceChannel MessageID = "lfg.now.invalid_voice_channel"
+	MsgLFGNowSpecificOrAny       MessageID = "lfg.now.specific_or_any"
+	MsgLFGNowPromptExpired       MessageID = "lfg.now.prompt_expired"
+)
+
+var lfgMessages = map[MessageID]Translations{
+	MsgLFGForumNotConfigured: {
+		discordgo.EnglishUS: "❌ LFG forum channel ID not configured.",
+	},
+	MsgLFGSearchQueryRequired: {
+		discordgo.EnglishUS:    "❌ Search query required.",
+		discordgo.SpanishES:    "❌ Se necesita un término de búsqueda.",
+		discordgo.German:       "❌ Bitte gib einen Suchbegriff ein.",
+		discordgo.PortugueseBR: "❌ É preciso informar um termo de busca.",
+		discordgo.French:       "❌ Une recherche est requise.",
+	},
+	MsgLFGGameNameRequired: {
+		discordgo.EnglishUS:    "❌ Game name required.",
+		discordgo.SpanishES:    "❌ Se necesita el nombre del juego.",
+		discordgo.German:       "❌ Bitte gib einen Spielnamen ein.",
+		discordgo.PortugueseBR: "❌ É preciso informar o nome do jogo.",
+		discordgo.French:       "❌ Le nom du jeu est requis.",
+	},
+	MsgLFGNoMoreSuggestions: {
+		discordgo.EnglishUS:    "No further suggestions available.",
+		discordgo.SpanishES:    "No hay más sugerencias.",
+		discordgo.German:       "Keine weiteren Vorschläge verfügbar.",
+		discordgo.PortugueseBR: "Não há mais sugestões.",
+		discordgo.French:       "Aucune autre suggestion disponible.",
+	},
+	MsgLFGInvalidSuggestion: {
+		discordgo.EnglishUS:    "❌ Invalid suggestion.",
+		discordgo.SpanishES:    "❌ Sugerencia no válida.",
+		discordgo.German:       "❌ Ungültiger Vorschlag.",
+		discordgo.PortugueseBR: "❌ Sugestão inválida.",
+		discordgo.French:       "❌ Suggestion invalide.",
+	},
+	MsgLFGGameDetailsUnavailable: {
+		discordgo.EnglishUS:    "❌ Unable to fetch game details.",
+		discordgo.SpanishES:    "❌ No se pudieron obtener los detalles del juego.",
+		discordgo.German:       "❌ Spieldetails konnten nicht geladen werden.",
+		discordgo.PortugueseBR: "❌ Não foi possível obter os detalhes do jogo.",
+		discordgo.French:       "❌ Impossible de récupérer les détails du jeu.",
+	},
+	MsgLFGGameHasNoName: {
+		discordgo.EnglishUS: "❌ Game has no name.",
+	},
+	MsgLFGGameLookupUnavailable: {
+		discordgo.EnglishUS:    "⚠️ Game lookup is temporarily unavailable. An admin has been notified, please try again later.",
+		discordgo.SpanishES:    "⚠️ La búsqueda de juegos no está disponible por ahora. Se ha avisado a un administrador, inténtalo más tarde.",
+		discordgo.German:       "⚠️ Die Spielsuche ist vorübergehend nicht verfügbar. Ein Admin wurde benachrichtigt, bitte versuche es später erneut.",
+		discordgo.PortugueseBR: "⚠️ A busca de jogos está temporariamente indisponível. Um admin foi avisado, tente novamente mais tarde.",
+		discordgo.French:       "⚠️ La recherche de jeux est temporairement indisponible. Un admin a été prévenu, réessaie plus tard.",
+	},
+	MsgLFGThreadRateLimited: {
+		discordgo.EnglishUS:    "⏳ You've created a lot of threads recently. You can create another <t:%d:R>.",
+		discordgo.SpanishES:    "⏳ Has creado muchos hilos recientemente. Podrás crear otro <t:%d:R>.",
+		discordgo.German:       "⏳ Du hast in letzter Zeit viele Threads erstellt. Du kannst <t:%d:R> wieder einen erstellen.",
+		discordgo.PortugueseBR: "⏳ Você criou muitos tópicos recentemente. Poderá criar outro <t:%d:R>.",
+		discordgo.French:       "⏳ Tu as créé beaucoup de fils récemment. Tu pourras en créer un autre <t:%d:R>.",
+	},
+	MsgLFGThreadCreateFailed: {
+		discordgo.EnglishUS:    "❌ Failed creating thread.",
+		discordgo.SpanishES:    "❌ No se pudo crear el hilo.",
+		discordgo.German:       "❌ Der Thread konnte nicht erstellt werden.",
+		discordgo.PortugueseBR: "❌ Não foi possível criar o tópico.",
+		discordgo.French:       "❌ Impossible de créer le fil.",
+	},
+	MsgLFGNowMessageRequired: {
+		discordgo.EnglishUS:    "❌ message required",
+		discordgo.SpanishES:    "❌ Se necesita un mensaje.",
+		discordgo.German:       "❌ Bitte gib eine Nachricht ein.",
+		discordgo.PortugueseBR: "❌ É preciso escrever uma mensagem.",
+		discordgo.French:       "❌ Un message est requis.",
+	},
+	MsgLFGNowInvalidPlayerCount: {
+		discordgo.EnglishUS: "❌ invalid player_count",
+	},
+	MsgLFGNowInvalidVoiceChannel: {
+		discordgo.EnglishUS: "❌ The provided voice_channel must be a voice or stage channel.",
+	},
+	MsgLFGNowSpecificOrAny: {
+		discordgo.EnglishUS:    "Are you looking to play a **specific game** or **any game**?",
+		discordgo.SpanishES:    "¿Buscas jugar a un **juego concreto** o a **cualquier juego**?",
+		discordgo.German:       "Suchst du Mitspieler für ein **bestimmtes Spiel** oder für **irgendein Spiel**?",
+		discordgo.PortugueseBR: "Você quer jogar um **jogo específico** ou **qualquer jogo**?",
+		discordgo.French:       "Tu cherches à jouer à un **jeu précis** ou à **n'importe quel jeu** ?",
+	},
+	MsgLFGNowPromptExpired: {
+		discordgo.EnglishUS:    "❌ This prompt has expired. Please run `/lfg now` again.",
+		discordgo.SpanishES:    "❌ Este aviso ha caducado. Vuelve a usar `/lfg now`.",
+		discordgo.German:       "❌ Diese Abfrage ist abgelaufen. Bitte führe `/lfg now` erneut aus.",
+		discordgo.PortugueseBR: "❌ Este aviso expirou. Use `/lfg now` novamente.",
+		discordgo.French:       "❌ Cette demande a expiré. Relance `/lfg now`.",
+	},
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestT(t *testing.T) {
+	t.Run("exact locale", func(t *testing.T) {
+		require.Equal(t, "❌ Ungültiger Vorschlag.", T(discordgo.German, MsgLFGInvalidSuggestion))
+		require.Equal(t, "❌ Sugestão inválida.", T(discordgo.PortugueseBR, MsgLFGInvalidSuggestion))
+	})
+
+	t.Run("regional variant falls back to same language", func(t *testing.T) {
+		require.Equal(t, T(discordgo.SpanishES, MsgLFGInvalidSuggestion), T(discordgo.SpanishLATAM, MsgLFGInvalidSuggestion))
+	})
+
+	t.Run("falls back to English", func(t *testing.T) {
+		require.Equal(t, "❌ Invalid suggestion.", T(discordgo.Japanese, MsgLFGInvalidSuggestion))
+		require.Equal(t, "❌ Invalid suggestion.", T(discordgo.EnglishGB, MsgLFGInvalidSuggestion))
+		require.Equal(t, "❌ Invalid suggestion.", T(discordgo.Unknown, MsgLFGInvalidSuggestion))
+	})
+
+	t.Run("formats args", func(t *testing.T) {
+		require.Equal(t, "⏳ Tu as créé beaucoup de fils récemment. Tu pourras en créer un autre <t:42:R>.", T(discordgo.French, MsgLFGThreadRateLimited, 42))
+	})
+
+	t.Run("unknown id renders the id", func(t *testing.T) {
+		require.Equal(t, "nope.missing", T(discordgo.German, MessageID("nope.missing")))
+	})
+}
+
+func TestCatalogHasEnglishForEveryMessage(t *testing.T) {
+	require.NotEmpty(t, catalog)
+	for id, tr := range catalog {
+		require.NotEmptyf(t, tr[discordgo.EnglishUS], "message %q has no en-US text", id)
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// localDateTimeLayouts are the accepted "date + time" forms for ParseLocalTime.
+var localDateTimeLayouts = []string{"2006-01-02 15:04", "2006-01-02 3:04pm", "2006-01-02 3pm"}
+
+// localClockLayouts are the accepted time-of-day forms for ParseLocalTime.
+var localClockLayouts = []string{"15:04", "3:04pm", "3pm"}
+
+// ParseLocalTime reads an absolute time typed by a user in loc. It accepts
+// "2025-06-01 15:00", "2025-06-01 3pm", "15:00", "3:30pm" and "3pm" (case and
+// a space before am/pm don't matter). A bare time of day means its next
+// occurrence after now.
+func ParseLocalTime(input string, loc *time.Location, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	s = strings.Replace(s, " am", "am", 1)
+	s = strings.Replace(s, " pm", "pm", 1)
+
+	for _, layout := range localDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range localClockLayouts {
+		clock, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		local := now.In(loc)
+		t := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+		if !t.After(now) {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q (try 2025-06-01 15:00, 15:00 or 3pm)", input)
+}
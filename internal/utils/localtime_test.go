@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocalTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	// 12:00 in Berlin (CEST, UTC+2)
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in   string
+		loc  *time.Location
+		want time.Time
+	}{
+		{"2024-06-03 15:00", berlin, time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC)},
+		{"2024-06-03 3pm", berlin, time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC)},
+		{"3pm", berlin, time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)},
+		{"3:30 PM", berlin, time.Date(2024, 6, 1, 13, 30, 0, 0, time.UTC)},
+		{"15:00", time.UTC, time.Date(2024, 6, 1, 15, 0, 0, 0, time.UTC)},
+		// Already past today in the user's zone: next day.
+		{"9am", berlin, time.Date(2024, 6, 2, 7, 0, 0, 0, time.UTC)},
+		{"11:00", berlin, time.Date(2024, 6, 2, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseLocalTime(tt.in, tt.loc, now)
+		require.NoError(t, err, tt.in)
+		require.True(t, tt.want.Equal(got), "%s: got %s, want %s", tt.in, got.UTC(), tt.want)
+	}
+
+	for _, bad := range []string{"", "tomorrow", "25:00", "2024-13-01 10:00"} {
+		_, err := ParseLocalTime(bad, time.UTC, now)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,36 @@
+package utils
+
+// SplitMessage splits text into chunks of at most maxLen runes,
+// preferring to break at newline boundaries.
+func SplitMessage(text string, maxLen int) []string {
+	runes := []rune(text)
+	if len(runes) <= maxLen {
+		return []string{text}
+	}
+
+	var chunks []string
+	for len(runes) > 0 {
+		if len(runes) <= maxLen {
+			chunks = append(chunks, string(runes))
+			break
+		}
+
+		// Look for the last newline within the rune limit
+		limit := min(maxLen, len(runes))
+		cut := -1
+		for i := limit - 1; i >= 0; i-- {
+			if runes[i] == '\n' {
+				cut = i + 1 // include the newline in the current chunk
+				break
+			}
+		}
+		if cut <= 0 {
+			// No newline found — hard cut at maxLen runes
+			cut = limit
+		}
+
+		chunks = append(chunks, string(runes[:cut]))
+		runes = runes[cut:]
+	}
+	return chunks
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitMessage(t *testing.T) {
+	t.Run("short text is a single chunk", func(t *testing.T) {
+		require.Equal(t, []string{"hello"}, SplitMessage("hello", 10))
+	})
+
+	t.Run("prefers newline boundaries", func(t *testing.T) {
+		chunks := SplitMessage("aaaa\nbbbb\ncccc", 10)
+		require.Equal(t, []string{"aaaa\nbbbb\n", "cccc"}, chunks)
+	})
+
+	t.Run("hard cuts without newlines and counts runes", func(t *testing.T) {
+		text := strings.Repeat("é", 25)
+		chunks := SplitMessage(text, 10)
+		require.Len(t, chunks, 3)
+		require.Equal(t, text, strings.Join(chunks, ""))
+		require.Equal(t, strings.Repeat("é", 10), chunks[0])
+	})
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"gamerpal/internal/config"
-	"slices"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -23,7 +22,5 @@ func IsSuperAdmin(ID string, config *config.Config) bool {
 	if config == nil {
 		return false
 	}
-
-	superAdmins := config.GetSuperAdmins()
-	return slices.Contains(superAdmins, ID)
+	return config.IsSuperAdmin(ID)
 }
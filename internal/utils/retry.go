@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// rateLimitMaxAttempts bounds how many times a rate-limited call is tried in total
+	rateLimitMaxAttempts = 4
+	// rateLimitBaseBackoff is used when Discord doesn't tell us how long to wait
+	rateLimitBaseBackoff = 500 * time.Millisecond
+	// rateLimitMaxWait caps any single wait so a bad header can't stall a loop
+	rateLimitMaxWait = 30 * time.Second
+)
+
+// rateLimitSleep is swapped out in tests.
+var rateLimitSleep = time.Sleep
+
+// WithRateLimitRetry runs a Discord API call and retries it when it fails with
+// a 429, honoring Discord's retry-after when present and otherwise backing off
+// exponentially. Non rate-limit errors are returned immediately.
+func WithRateLimitRetry[T any](fn func() (T, error)) (T, error) {
+	var (
+		res T
+		err error
+	)
+	for attempt := 0; attempt < rateLimitMaxAttempts; attempt++ {
+		res, err = fn()
+		if err == nil {
+			return res, nil
+		}
+		wait, limited := rateLimitRetryAfter(err)
+		if !limited || attempt == rateLimitMaxAttempts-1 {
+			break
+		}
+		if wait <= 0 {
+			wait = rateLimitBaseBackoff << attempt
+		}
+		rateLimitSleep(min(wait, rateLimitMaxWait))
+	}
+	return res, err
+}
+
+// RetryOnRateLimit is WithRateLimitRetry for calls that only return an error.
+func RetryOnRateLimit(fn func() error) error {
+	_, err := WithRateLimitRetry(func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// rateLimitRetryAfter reports whether err is a Discord rate-limit error and,
+// if known, how long Discord asked us to wait.
+func rateLimitRetryAfter(err error) (time.Duration, bool) {
+	var rlErr *discordgo.RateLimitError
+	if errors.As(err, &rlErr) {
+		if rlErr.RateLimit != nil && rlErr.TooManyRequests != nil {
+			return rlErr.RetryAfter, true
+		}
+		return 0, true
+	}
+
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusTooManyRequests {
+		if secs, perr := strconv.ParseFloat(restErr.Response.Header.Get("Retry-After"), 64); perr == nil {
+			return time.Duration(secs * float64(time.Second)), true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+func stubRateLimitSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var waits []time.Duration
+	orig := rateLimitSleep
+	rateLimitSleep = func(d time.Duration) { waits = append(waits, d) }
+	t.Cleanup(func() { rateLimitSleep = orig })
+	return &waits
+}
+
+func TestWithRateLimitRetry(t *testing.T) {
+	t.Run("retries after rate limit then succeeds", func(t *testing.T) {
+		waits := stubRateLimitSleep(t)
+		calls := 0
+		got, err := WithRateLimitRetry(func() (string, error) {
+			calls++
+			if calls == 1 {
+				return "", &discordgo.RateLimitError{RateLimit: &discordgo.RateLimit{
+					TooManyRequests: &discordgo.TooManyRequests{RetryAfter: 2 * time.Second},
+					URL:             "https://discord.com/api/channels/1",
+				}}
+			}
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ok", got)
+		require.Equal(t, 2, calls)
+		require.Equal(t, []time.Duration{2 * time.Second}, *waits)
+	})
+
+	t.Run("honors Retry-After header on REST 429", func(t *testing.T) {
+		waits := stubRateLimitSleep(t)
+		calls := 0
+		err := RetryOnRateLimit(func() error {
+			calls++
+			if calls < 3 {
+				resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+				resp.Header.Set("Retry-After", "1.5")
+				return &discordgo.RESTError{Response: resp}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+		require.Equal(t, []time.Duration{1500 * time.Millisecond, 1500 * time.Millisecond}, *waits)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		waits := stubRateLimitSleep(t)
+		calls := 0
+		err := RetryOnRateLimit(func() error {
+			calls++
+			return &discordgo.RateLimitError{RateLimit: &discordgo.RateLimit{}}
+		})
+		require.Error(t, err)
+		require.Equal(t, rateLimitMaxAttempts, calls)
+		require.Len(t, *waits, rateLimitMaxAttempts-1)
+		require.Equal(t, rateLimitBaseBackoff, (*waits)[0])
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		stubRateLimitSleep(t)
+		calls := 0
+		err := RetryOnRateLimit(func() error {
+			calls++
+			return errors.New("missing access")
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+}